@@ -29,17 +29,17 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Mo#\xbb\x91w\xff\x8a\x82\xf7\xe0\x04\xb0\xe4\frY\xe86\xcf\xe3\x87վY?c\xecx\x0f\x8b=P\xdd\xd5\x12c6\xd9K\xb2ek\x83\xfc\xf7\x80E\xb2\xbf\xbf4\xe3d\xf0\xf0\x86'\xbbE\x16\x8b\xf5\xcdb\x91\x17\xab\xd5\xea\x82\x15\xfc\x19\xb5\xe1Jn\x80\x15\x1c\xdf,J\xf7\x9fY\xbf\xfc\xbbYsus\xfc\xb0C\xcb>\\\xbcp\x99n\xe0\xb64V\xe5_ШR'\xf8\t3.\xb9\xe5J^\xe4hY\xca,\xdb\\\x000)\x95e\xee\xb3q\xff\x02$JZ\xad\x84@\xbdڣ\\\xbf\x94;ܕ\\\xa4\xa8i\x868\xff\xf1O\xeb?\xaf\xfft\x01\x90h\xa4\xe1O<GcY^l@\x96B\\\x00H\x96\xe3\x06v,y)\v\xb3>\xa2@\xad\xd6\\]\x98\x02\x13\x9a:M\t\x1f&\x1e4\x97\x16\xf5\xad\x12e\xee\xf1X\xc1\u007f>\xfez\xff\xc0\xeca\x03kc\x99-ͺ80\x83\x84c\x8a&Ѽ\xb0\x84\xc9O4\x01\xf8N`\xca\xe4\x00\xcc\xc0V>h\xb5\xd7h\xccͭ\xca\v\x81\x16S\x1a\xeb\xb1z\xa4\xde\xf4\xc1\x9e\n܀\xb1\x9a\xcb\xfd\xc8̨\xb5Ҧ?\xf5\xad*\xa5\x05\x95\x01\x13\x02\xa8\x13\xe4h\fۣ\x01{`\x16^Q#\xecQ\xa2f\x16SHK7\t\xe0\x1b&%q\x02\xa8\xa9\f쁛@\xaa\x06\x96w\xf5\xbc\x1eKG\xa6=\xea\x114_\x99\x96\\\xee\xe7\x10\r\xdd\xde\x17\xd5\xffnν\x04Yc\x99\xb6\x95\xd0\xf4Qv?\xc1\xeb\x01esBxe\x06hd\x8b\x9b\xb7N\x06\xc3\x17?w\xca,\xf6&.0Y\x1b\xab4\xdb\xe3g\x95\xb0jY\xady\xefY\x8e~\x99\x18E\xebя\x818ȡ\xa5\xb1\x85\x979\xa8R\xa4\xb0Cp\x13tD\xad=zV\xe8\xa2z\xae{\xaaՀ\xfaq\x8f\xfd\xe5\xee\xb5*\x8b\rԪ\xe6{\a\xcd\xf6V᧚s\x82\x1b\xfbK\xe3\xe3gn,\xfdP\x88R3Q\xe9.}3\\\xeeK\xc1\xf4\xa6\xe6}\xa1Ѡ>\xe2_\xe4\x8bT\xaf\xf2g\x8e\"5\x1bȘ =5\x89r\xb89\x82\x9a\x82%D\x14S\xeet0Hf\x03\u007f\xfb\xfb\x05\xc0\x91\t\x9e\xd2:=\x9a\xaa@\xf9\xf1a\xfb\xfc\xe7\xc7\xe4\x809\xdb\x04\xc9\x1b\xd2yn\x80\xc13\xad\x16\"X/\xcd\x1a\t9i\r12a\x85-5\xf1\xf5\x97r\x87Z\xa2E\x13\x00\x03$\xa24\x165\x99\x10\x04f\x81A\xa1\xb8\xb4\xc0%X'\x86\u007f\xf8\xf8\xb0\x05\xb5\xfb+&\xd6\x00\x93)0cT\xc2IQ\x8e\xceh\xa1\x1f\xfb\xc7u\x80YhU\xa0\xb6<\x92\u07b5\x86\xf5\xae\xbeu\x96u\xe5\xd6\xed\xfb@\xea\xec5z\xf4\x83\xd5\xc5\x14\fѤR\xc3j\x99\xac\xa1\xa3\x95\xa62\x19\x90^ã\xe3\x936QN\x13%\x8f\xa8\x1d\x99\x12\xb5\x97\xfc\xff+\xc8\x06\xac\xa2)\x05\xb3\x18\xa4!6\xb2ђ\tǱ\x12\xaf\x89\x109;\x81F7\a\x94\xb2\x01\x8d\xba\x985\xfc\x97\xd2\b\\fj\x03\ak\v\xb3\xb9\xb9\xd9s\x1b\xfdU\xa2\xf2\xbc\x94ܞn\xc8\xeb\xf0]i\x9567)\x1eQ\xdc\x18\xbe_1\x9d\x1c\xb8\xc5\xc41\xef\x86\x15|E\x88KrW\xeb<\xfd\xb7J\x96\xae\x1a\x98vt\xcb7\x12\xfeQ\xba;-\xf0\xd2\xe4\x87y\xfck\xf2\xbaO\x8e*_\xee\x1e\x9f\x9a\x92\xc6M\x9b\xe6D\xed\x86\xf0Մw\x84\xe22C\xed\x19\x97i\x95\x13D\x94\xa9\x975\x12S\xc1Q\xb6\x89n\xca]έ\xe3\xf4\xff\x95h\x9c8\xab5ܒ\xd7v֦,\x9c\xea\xa7k\xd8J\xb8e9\x8a[f\xf0\x9fNvGa\xb3r$\x9d'|3\xd8hw\xf4Ԫ>ǰ`\x90C^\xe1\x1f\vLZ\x8a\xe1\xc6\xf0\x8c\a\xb3\x9c)]\xdb\x03o\xa5\xd6\r\x80CJ\xe9'\xcaX)\xec3)\xb2yR_\xd0X\x9e\xb4\xfbt\xd0\xf948$\xa2\x83\xc6y\b{@\xedd\x85~ \xb5\xeb@\x04b\xa0\xc1\x94t\x8e\xbd \xb0\xe8N\x82\xa7.T\xb4/\x06v\xa7\x88\xe8\xba\x03\xc7Ss\xa7\x94@ֶ\x01\xf8\x96\x882Ŵ2\xc1frUw\xbd\xee\x14\r2.\x9df8o\xe1\x10\x93\xf5\xafdj\x99\xc6\xde\u009ctr顑\x15=\xe0\x00C\\\xe3\x16\xf3\x1eV#\xa2\x14`\x97B\xb0\x9d\xc0\rX]v\xa7\xf6\xe3\x98\xd6\xec4H\x89/\x95\xf3YB\x88\xaaw\xb0\r\x82'\xe4C*\v@\xb4\xf8\r\x91\xe1\xa0\xd4\xcb\xf4\xd2\xff\xc3\xf5\xa8-\x18$\xb4\x89\x80\x1d\x1eؑ+\x1d\x16[\x87;>@ľl3\v)\xcf2\xd4\x0e\n\x85\xee&\x86U\xc3$\x18SO\xd7\xf4\x18\xdbz\xf8\xd7,c\x1a\xfdz\xc7P\xf6ѥC\xa6O]\xdf\\\x88!S~\xe4i\xc9\x04pi,\x93\x89_\a\xabp\xea\xae\x03\xc6\xd9\xd9\xc3֛\xb5\x88\xb3\xa3}\xcb\xc4)\x89\xe0\xf6\x14Κ\xf7\xbb\x9aA\xf80\xba\xdc\x1ds\xb6Fy1ԥ@\x13&J\xc9r\xd6z}=\x02\xb8\xe2\x82\xf7\xfd\x82\xedP\x80A\x81\x89Uz\x88\f\xd3L\xf5m\xdeF\x8d\xd0n\xc0Z\xd5\xf6\xd7-\xb1i\xa8\xd4(L\x80\xd7\x03O\x0e\xde-;y!(\x90*4\xa4\xbf\xac(\xc4ixq0\xcdi\xdf&T\xb8n\x93\xca܅\xd5W\xeb\xba\xcdڹ\xba\xcdX\xbc6-+\xd6\xff~H\x19\r\xf7ق\xb9\xed\r|O\xc1tD\xe4.\xb4\xdef\x80yaO\xd7\xc0m\xfc\xea\"\tFɗQ\xf2Ts\xff\xe6\x18q\xaeLo\xbb\xe3\xdeQ\xa6\xbf\x91\v\xd5Կ\x19&\x90\xb1\u007f\f\xb6~!\x03>7\xc7\\\x03\xcf*\x06\xa4אqaQw81\xb5\\5͉o%\xc1\xbc\xa7r-g69ܽ\xb9\xe8\xc8\xd49\xd3E\xd4\xe8\x0e\xf51e\x8c\xaa\xdb\xcet\x12*\xd0f\x90k\xcc\xfd\x16\xf3\x89(X\u007f\xa1\xc8\xe7\xe3\xfd'Lǉ\x02K$\xac\xb7\x84\x8f\x1d4\x9bӆ\x10y\xd9\x02B\x90R\xed.|\xba\xe0\x1a\x18\xbc\xe0\xc9G\x17L\x82c\bsӸγ\x105R\u0382\x04\xea\x05O\x04$\xa4!f\xc6.c\xbdo/x\x9a\xef\xd4!\x9bÆ\x9b\x90Vq\xf4s\x1f\x88\x00\xb4\x87]J2\xa0$R\xb40s\x8b\x82\xa5&\"\xb6H\xed\xb3\x97W\xb1\xa9\x91t#F^\x19\xcf\x14'\xed\a^,Z\xa03\x9d`\x90t\"&\x91\x9e\x99\xe0i5\x8d\x97ﭼ\x86{e\xb7r,Xm\xb7\xbb7nB\xee\xee\x93Bs\xaf,}yw\"z\x94\xcf&\xa1\x1fF*$\xbd\x19v\xebo\xe6\xa2f\x85ط\xad\xdfaU,\xe1\x06\xb6\xd2\xed!<\xad|6\xd1O6e\xed\xdb-/\r%\x9b\xa4\x92+rv\xeb\xa1y\x02\x89\x17\nr\x93\v}\xb4\xaa)\xfdt\x8b >9\xbf\xe0G\xfb̨`I}\x90A\x99=fq\xcf\x13\xc8Q\xef\xc7\x1dA\xb3\x15\xcef/\x99~\x91-\xf5\xed,yZ\xe2\x9ac\v\xc68\x9dCc\xe5ts\xb6Od\xedL\xc7\xc1T\xdexǹu\x90\x93\xa4\xb8a\x86\x9a\xcd\xc3å\xd6{1\xe5\xfb~ۣ\xe4}\\\xce(A\xf77\xe7\xaaHh\xff\x0e\x05\xe3zVC?\xd2\x19\x8a\xc0\xd6Ȑ\x15jN\xe2\xe0s\x03\x8e\x9bG&\xba\t\xe1\x81e)g5Px7\xac\xb2^\xa4q\r\xaf\ae\xbcW\xcc8\x8a\x14\xf8T\xa4\xe5\xda\xe5\v\x9e.\xaf{:~\xb9\x95\x97\xde=\xf746\xfa\xf2\x19\xc0J\x8a\x13\\\xd2\xc8˯\x0f]\x16I݂Nt\x96\xb6,\x98\xa5sA^o\xe0\xaa3\x18\x17\x8a\x8ec\xbb@\xe6\ne\xecB$\x1e\x94\xb1>C\xd7\n\x1e\arC\xd3{\x9a\x90\x13\x02\x96\xf9s/\xa5\xe3\t\x873d\x9dT\xa5\xe3\x92\xc1\xc1\x04g\x0fb\x1a@2!\xe0\xb2\xd6Qo\x1f/\xfd\xb1\aM\xc1\x12\n\v& :Q(\xb4JИ)q\x98\xb5\xbc3\t\xb7*\xd9\xc6\xfc\xa6\xc2\x1f\"L%\xf7b[\x1a6:Ҝ\x15f߽5r\x80N\xb5\xdd\xff\xd3bv\x1eF@u\x1ey\xce䬳\xe8!w\xeb\xc7EU\b`|Ȯ\xf7%\xa9\xf1\xd2H/\b\xcd\xf7u\xb09\x97[\x02\x0e\x1f\xde\xd5\x1dC4\x89x~H}\x1bG\xd6d\xae>x\xdd,T?\xe5>\xd4b\xa9Bͩ~f\x98\xc29\xa9lc{\xbe\x8c\xd0\x1e\x8f+\x03\x19\xd7\xc66\x914t\xb0\xf5\xfe{\x14I%1g\xd3\xf3W?\xae\x91\x00:\xa8\xd7xR8r87\xd4\xe8\x18\x04\x81g\xc0-\xa0LT))\x89ᔔ&\b\xf52dLg\x9d\xacoK\x14\xdb5\x94e\xbed\xe1+\x92\x1e.'r\x1d\xcd\xce?3>\x95\xa9\x8a\xed,6Y\x9e\xa3*'\x9cZ\xddz5?\xaa\xb4\xad#ޜ\xbd\xf1\xbć\xe5\x8e؋(\xea<3ϱ\xcd_xeܒuwP\xc9\xd4[唂j\xc3\x16r?S\x9at\xd1\xf0\x14+\x97Y\xd5H\x01\x83\x8cqQ\xeaE\x16\xed\f\x8a.\x8f샒\xbfOоd\xda\x15-\u007f6M\xb9(T\x9b\xb2\xaa\x85^\x1a\xa8=h|\xcf\x10\xa9\xd0\xdcɌz\xdf()\x88\x12\x93\xa7\x1faR\x836?¤^\xfb\x11&\xb5ڏ0\xe9G\x984\xd9~\x84I?¤\xdfk\x984\x8dɊ\xf2V\x83?\xcd\xcc>{\x84:\x8e\xd8(\xe4p\xaa\u007f\xebk\xaf\x97\xd5\xe5m\x87\xc7\f\xd4]\x86\x92\xee\x15ՠ\xf7\xf9\\\x1f\xfd\xd7f\xbe*\xd4s\xc2\x1f\x85\xd7\x17\x96N\x96\xee-(\xc4\x1b\xaa͜//\x99+*i\xd7$V\x85\x1d\xb1(Q\xc5)z\xab\x8f\x95\xec.\xcclV00!\x9a\xb5)L\xd7D\xf9N\xf5\x8a\xb3\xa5\x1f3\x05\x1f\xd3e\x9b\xe3\x14\xea\x84\xf6m\x12\xe9V\x89\xe1w\xa6\xd0d]\xc6x5F8\xc9@ˎ\x1f\xd6\xed_\xac\n\xb5\x19\xf0\xca\xed\xa1\xb7\x00*\x9at[\x16\xb9o\x16GF\x99\n\xd7\a\xba\x94\x03\xa5Arq=X\x17Sݬh\x92\x13~-\xfc\xa6\xe8,}\x9b\n\xed\x97\xd4n|u\xc5F\xbb&c\xd0Ȟwر\xb4\x84tyMF\xbb\xe6b\xc4\xc9,\xa8\xc48\xbb\xd2b~\xbf5YU\xf1\x15\xb5\x14\xb1Nb\xca\xe1NTP,\x889\xe6\xab%\xbe\xaaF\x82\x0e\xf3&\xb0>\xab2\xa2Q\xf50\x01rY=\xc4\x02\x92\xcc\xd5>\x9c]\xf1Э2\x98X\xc4\\\x9d\xc3x\r\xc3\x04\xd0\xc1\xea\x86%\x95\v\x130\xab\x9a\x86w\xacW\x98\xa9Rx\x9fJ\xc2o\x8d=\xc7j\x0ef*\rf\"\xd3)\xacfj\t\x96W\x10\xcc\xd0\xe7+\xab\x05\xaaz\x80\xc19ϭ\x11hW\x01\f\x82\\X\x190r\xf6?\brA=\xc0̉\xff \xd8I\xc78!\x11\xa3?)\x9d\xa2\x9e\b#\x97\xc9\u0084\x1c\xb4\xd3(\x9d\xd9:u\xc7\xf1\x8e\x97\xeb\xd5\fK\xfb\xb4PU\xc5l\x02\xbfp\x99z\xf29\xde7\xdc \xdd]\xa4\x8a\x84\xca\x0fׁ\xca\x10\xc8N\x18l\xb0`\xfe\xce\xf7\xee\xe47\xc6f\rw,9\xb4;\u0081\x19\xb75\xca\aJ1/\xab]\xc3M\x1c\xe3\xbe\\\xae\x01~V\xd5f\xacy\u007f\xc4\xf0\xbc\x10'(\r\xc2e{\xc8\xf9A\xf1\x00\xbf\x8dd\x859\xa8xAo2.~l\xf7\x1d\xd8L\xc6\xeby\x89PeZ\xc1\x1ed\x17\x93'xx&\xa7NW\x9f\x92\xfa\xe2Wp\xdd1\xd8\xed\xde\v\xfb\xe9=7\x97\x9d[\xee\xd3\xebo\xf7m]\x83\rJ\x1cS8\xb1\xee\x85\xc5\xfb\x98\x03\x97雭Ϊ\x8e\\\x93\x1f\xbe\xca8\xa0a֊\xc9E<=}\xf6\x88[\x9e\xe3\xfaS\xe97\ueac2i\x83\x8e~qA~\xd0\xce\xfdyP\xaf=\x84\x85\n+\xfd\xa9\x8b\xafF\xca\xd9Rv`1\xd6\xfe\xeef\x14\xb0H\xa6iq|\x1e\x1e\xd3\xd8{4\x98\xe25Xec\xa3z\vl\\\\w\xbb\xbb\xe6\x1b\x06߾\x81\x1dv\xc6×}\xe9\x11\x88\xb9\xeb\xbe\xfeI\x8fpy?d\xf8KM7\n\xc3s\x1f$\x8cg\xdf\xf8\r\xe9\xcc֫%S<\xb9\xed\xf7\xa7\xab\xf3:\xf5HQ\x1a\x955ߨ\x88\t\xd3\x01\x0fV\x03\xf3\xe3(\xf8s\xb00\x05<\xa2\x04%)?J7\xf6\xfc\xdb)\xdd1\xfd|E\x03FH\xbf\x96\x85P,\x8d\x9a\x1b}Nx\x0e\xe0\x89\xec\x91>\xa2\xbe2\xa3\x10\xe9jr\xa6\xf4\xd0\xf2\xbb\x92\xe5\x1d\x83\u007f\x88b5\x00p\x81\x1d\x1b\x10)\xff\xee\xca\xf4U]\xea\xe2\xb5#9\xef9\x96ޚ\xbbo\x9et\xee\xab\xfa\x1d<Kl\xc9\x02\xf8\x98\xb2h\xf4\xba\xea\xeb\x9cP{ȸ\xa0\x8eᅀ`\x9f\x87\rI\xfdnJ\x83\x12o\x05\xd7\xf3\xb6\xfc\xae\xea\xe6(R\xbf\x9eR\xbf\x97\x81\x82\xef\xb93\x88\x8e\xb1{\xa6wl\x8f\xabD\t\xb7o\xe6Jv1\xfa\xe7\xf0\xd5C\x1dx\r\xa3\xb7\xa0\x9f\x9b=c\xc4\x13\x84\xd9C\x89\x8fc\\\a\x8fJ/۰\xbf*\xdd?\x9c˹Tڇ\xab\xb4e\x8aC\x17\xdbs\xba\xc6<\x89\xef\x83\xebQ\x9dF6lU\xe7M\x99\xee\x94C\xa7V+\xb8Ǯ\x8b\xf2\aQ\x98>W\x8f\xa6\xf4:\xd4/\x1f\xf5~j\xbf\x84\xd4\xfc\xe5\x81i˙\x10'\x0f~d\xd6\xde\xe7O\xe8\xec\u0088#\x18\"`\xc0l\x9a\x86\xa1S\xbd\x85\xe0\xd2\xf3\x9a\x0e\x8fv\xaa\xb4-\x85\xeb=Rԟo\r\xf7\xcab\xcc\x13\xf16D\xe7\x01\xd1\xd8\x15f\x99\xd2\xd6\xefWV+\xe0Yp,=\xa8\xce:S\xa6ӿ\xbe\x01\xdcֻ\xf6Z6)\x16\xd4\xc8\fɦ\xa5\x17@蘁%\x89\x8bO\xf0\xc6X&z6\xe0\xabӛ䯝ta\xfa\x97\x9e;\xeb\x11y\xdb\xec]\xd55\x97\xf9\xce\xefI\b\x98\xa7\x17\x1d\xe5z\xab'\x86\xb7\xf0;D\t\xaf\x9a[\xeb\xecM3\x01\f\xd6Y\x18!\xc0(\xc8\xd8\xe0\x1d\xf1q\x9bG\xbf*\xcb\xc4v,\x81\xd1\x0e\x01\xab\xaeq94\xb8\xbf(\xe5ذ\xa3\xa5\x0f.\xc7\x17\xf3p\x13G:\xc6%\a&\xf7N\x80\xb4*\xf7\x87(\x81#\x9eb8i[:\x84\xa0\x10\xe5މtH\xa4\xdaR\xcb\xc6\xee3\xa4V\xd3\x06\xaa,y\x81\xb2\x18\xae4\xf0o\x03\x85Ǟn\xc2\xdd\xefU\xa6U\xbe\n\xf4\xa7\x1c\xe9u\xd8\x19j\xae\\\xc8D{\x9ap\xfdr\x04,\xb1\xbd(P\x023\x01\x97\xd9:\xa3)F\x8eo\xd4Z\xaf\x80\xcd\xecS\x9a]g\xe2\xaf\xf0F\xd8\x1a\x1e\xc3\uedaf\xc4Z\xe5\xfe\xe1\xb0f\\\xe3v\xa62>\"\xe5\xf7Ҟ\xf5ƅe\x1ai\xdb\xe2\xef\\\xf6 \xb6\x02\xaaV\x00\xd5F\xfd_\x13;\xd5\xcfj\xdd\xcdGQϝΝ\x833\xa7\xc15\xbc\x18\xfb\xfc\x81g\xfd\xfdEQ\b\x9e8l\xff\xf8\x9d\x0eĎ\v\xa2\x8a\xabɀ\x82\xa2\x87*6\x80OXhL\x9cV\xf6\x91\u007f\x10\xe8\xfc\xbdAlG*W\x8b\x03\xbb\xf6\x16\xd1|\xb4\x16\xf3b`\xae\x89=b=h\xcc\xf0\xb1ء\xb7\x80\xf8zY\x04\x15*?F7\x85\x8b\x17R\x85\x1a\xe7,\xa4\x1a4\xb6\x10S&\xce\x00e\xe5\x90+\xaa\xf6\\︪\xf8\xa4\xe2\xe4*\xe2ۇ\x03\xbb\x90%o-\x9e\xbd\x0filC\"~\xff\xa2\x8dȀ\x1d\xef|\xaa\x1f\b\xfdP\xff\x17\x9e\xf4\xf4/\x17\x1e}\xfd Y˴\xa1\xda\x01\x95\xf0\xa5N\x10\xb0$A'\xbb\xf7ݗ\f//\xe9\x9f\xf8X!\xfd\x9b(\xe9}\xa9\xd9\xc0\xff\xfc\xef\x05\x84<\xd3s\xc4\xc3}\xfcG\x00\x00\x00\xff\xff/\x18y\xfcLU\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKo#\xb9\x11\xbe\xebW\x14f\x0f\xbe\x8cZ3\xd9K\xa0K\xa0\x91\xb3\xc0$\x9e\xb11\xf2:\x87$\xc0R\xcdj\x89\x11\x9b\xec\xf0!\xad\x12\xe4\xbf\aE\xb2\xdf-\xc9^\xecn_\xecn\x92ŏU\xf5Ճ\x9a\xcd\xe7\xf3\x19\xab\xc4\v\x1a+\xb4Z\x02\xab\x04\xfe\xecPћ\xcd\x0e\u007f\xb4\x99Ћ\xe3\xc7-:\xf6qv\x10\x8a/a\xed\xad\xd3\xe57\xb4ڛ\x1c\xef\xb1\x10J8\xa1լD\xc78sl9\x03`Ji\xc7賥W\x80\\+g\xb4\x94h\xe6;T\xd9\xc1oq\xeb\x85\xe4h\xc2\x0e\xf5\xfe\xc7\x0f\xd9\xf7ه\x19@n0,\u007f\x16%Z\xc7\xcaj\t\xcaK9\x03P\xac\xc4%lY~\xf0\x95uڰ\x1dJ\x9dǽ\xb2#J4:\x13zf+\xcc\x03\x12\xce\x03<&\x9f\x8cP\x0e\xcdZK_FXs\xf8\xcb\xe6\xf1\xeb\x13s\xfb%d\xb4 \xab\x8c>\n\x8e&`\xe6hs#*\x17\x90=\xa5\x11\xd0\x05\xb8=&\x00\x90\x10\x84\xf9\x11\xd9SW\x84;W\xb8\x04\xeb\x8cP\xbb\xc9\r\xf5\xf6_\x98\xbbM\x94\x92m}~@7\xde\xfcS\xf8\x0eN\x83\xb7\b\x856\x10\xd7Ml\xff\xa9\x15qusǜ\xb7Y\xb5g\x16'\xf6\x8b\x87K\xb0\xe0!\xe9\x17\xe2*\xb0>\xdf\x03\xb3\xb0:2!\xd9V\xe2\xe2G\xc5\xea\xff\xbb\xaah\xa4\xbf\x02\x8adֽ0)xc\xf71\xae\x87\xd1\x1c\x106\x98\x83V\x83\xa3\x0f\x03\xe3 \xd4\xde\x01'f\x83H\x80c\x94\x81\xbc\x03\x96d\xc3Ko \xa2\xa6\xf7I۱<Gk\xbfh>\x81\xf4\tM)l\xa0Q\xb0\xd7\xd8e\x1a\\\x1d\f\xab \x11\x1a\x91\xd7\xd4V\xd3-\x1bQ\xa5+p\x87\xaf9\tǂy9\xe1x\xf7q\xe0\x15\xd0\xef;\"\xe2n[\xad%2\x9a\xb33\xdaWKh\xc9\x19\x17\xa5\xd0\x10\xc3Jt\xb9\xe4q\x0f]\xf9RX\xf7\xd7\xcbs\x1e\x84\x8d\xbbV\xd2\x1b&/\x85\x860\xc5\xee\xb5q_ۭ簵2\x8e\b\xb5\xf3\x92\x99\v\xcbg\x00\x95A\x8b\xe6\x88?\xaa\x83\xd2'\xf5\x83@\xc9\xed\x12\n&\x83\x8f\xdb\\ӡ\x83\xf0\x8a\xe5\xc1\x83\xacߚ\x14'ӆ\xd1ח\xf0\xdf\xff\xcd\x1a/$E\x87A]\xa1Z=}~\xf9~\x93\xef\xb1d\xcb\xe4\xac\x13\xcc\x1c\xa8\x80H\xc0:~\xbeG\x83\xf0\x12\xb4\x1d9`ө\x92DHᣦCet\x85Ɖ\x1a%=\x9d\xac\xd0|\x1b`\xb9#\xb0q\x0ep\xca\x03\x18\xb9\x98\xa29r\xb0\xe1 1d\n\v\x06\x83\x12\x95k\x8d\xdb\x00*\x80\xa9\x04+\x83\r)\xdaX\xb2\x97\x97\x9c\x92\xc7\x11\x8d\x03\x83\xb9\xde)\xf1\x9fF\xb2\xa5\x90\x18\xe9\xef0\xb9A\xfd\x84`\xaf\x98$5{|\x0fLq(\xd9\x19\f\x86\xc8\xe9UGZ\x98b3\xf8B\xf1B\xa8B/a\xef\\e\x97\x8b\xc5N\xb8:\x0f\xe6\xba,\xbd\x12\xee\xbc\b\xd9Ll\xbd\xd3\xc6.8\x1eQ.\xac\xd8͙\xc9\xf7\xc2a\xee\xbc\xc1\x05\xab\xc4<\x00W15\x95\xfc\xbb\xc6\x19\xee:H\a\x1c\x8fO\xe0\xc4E\xbd\x13\x1b\xa2\xcd㲈\xbfU/}\"\xad|\xfb\xf3\xe6\x19\xeaM\x83\t\xfa:\x8f9\xa4Yf[œ\xa2\x84*\xd0D\xc3\x15F\x97A\"*^i\xa1\\xɥ@\xd5W\xba\xf5\xdbR8\xb2\xf4\xbf=ZG\xf6\xc9`\x1d\xaa\x01\xd8\"\xf8*\x04\xd7\f>+X\xb3\x12\xe5\x9aY\xfc\xcd\xd5N\x1a\xb6sR\xe9m\xc5w\x8b\x98\xfeĨ\xad\xe6s]_LZh\x92\xa5\x9b\n\xf3\x1eO8Zaȗ\x1ds\x18\x18\x90H\xdbS\xe9\xe5\xc0x\x99\xbc\x81\xc0Mv\xea\u007f\x1f@]5\xd3zت\x9b\xf9k \x14\x9a\xf8\x93\rFP\xf9r\ba\x0eߐ\xf1G%ϓ\x03\u007f3\xc2\r7\x984\x17=\x11\xd6\xe6\xac\xf2'4B\xf3\xab\xc7\xfd4\x98\xdc\x1cz\xafOP\x04\xb7UN\x9e)\xaeسʇq\xb3~VO\x9f\xeb\x18\x1a\xc9ѯ\xc72X%N\xea\x02>\x00\x17\x96*#\x1bD\x0e\xd5Ce-\x8d.\xc1\x19\xff\xeaC\xe7Z\x15b7<j\xb7\u061d\xf6\x8a\xabB\a\xbaZ\x87=(А\aԥ\xf1\x9c<_\x14\"O\x18\xbc\x89Y\xa7\b\tqx\xbaI\xee\x84\x03\x18\xe4\xc4Q&\xaf\xdak\xddL\v-\x04\x13*\xfag\xbb<\x04\x0eS\xa6D\xa8\x1c*\x9eʷ\x1e\x0e\x1d\xe2\x8fE\x0e'\xe1\xf61\xac\xc91\x9b\xe0\n\xa3\xe89\xe0y\xfcq\x80\xf9y\x8f4\xaf\xee\x14,\xe6&\x16\xef\x16%9\t9L\x06\xf0\xc5\xdb\x10\x14Y,\x04&\xa4B\xbd\xf6\x80\xe7\xa1bo\x182\x95e\xb7\xa0\xdeQ\xbdR\x035X\xa0A\xe5&\x032ulF\xa1\xc3\x10\x93\xb9\xce-\x85\xe3\x1c+g\x17\xfa\x88\xe6(\xf0\xb48is\x10j7'\x15\xcf\x13?\x16\xa1\xd4[|\x17\xfeL\x1e\xf2\xf9\xf1\xfeq\t+\xceA\xbb=\x1a\xb2R\xe1e\xedP\x9dJ\xe4}ȋ\xef\xc1\v\xfe\xa7\xbb\xb7\xeaCW\x91\x197u\xb2\t\xfe}\xa62*\xc0!\xd5l\xa2\x1d\xb4\x01\xcand\xdc2Y/Ə)\xeb\r\xab\xe0\xeeC\x81\x86b\xff8.\x1ep\x18\x12/R(U\xedW\xf9S\x17\xf0Bq\x91S\x91\xd4\xf7\xfc\xba}\xe2\x93u\xfe\xabC\xfc\xe5\xa3\xf6\xfa۫H\x1f\xbb3ێ3\x06\x9b\x94\x95,:*n,(\xa4\xac\xc5\xccPW\x81\xe8\xb9V\x8ax\xe64\xb0&l\xdd\xd9a\x8c~\x03\xebc_~\xd3sR\x9b\x9et\xba\xbdѴ\xbf\x99\xd19[\xa3\xb9\x8db\xbd\xa2iMbc\xb0^\xc1\xd6+.\xb1\xc6rڣ\xa2*]\x14g*\x15\x9f\x1f6\x93\xbcLz\f5@\xaa\xb3kmNa\x8fQx\t\xdb\xf3(w\xdf<Ze\xb0\x10?\xdf<\xdaS\x98V+\xb8bn\x0fBY\xc1)\x88\x8e\xd5=QL\xd5O\x93\xa7\x1fSTx\xa31.\xf3\xb7s\x853\x944A\xe1Z\x9fW\x99\xd1\\=\xd5\xe7\xbez\x155\xcd͉S\xb4\xed\xe7\x0f\xb1\xee\xc9G\xa9\xad\a\xe3e<\xffJ\xf5T_\xb1\x8c\tJ\xd9[\x1b\x83\xb6Ҋ\x93\xff\xbd\xaevj\xe1\xfe\x1a\x15Ԕ\x01\xe7\xfdh\xd5\x1b\xe9]\f^1jj\xf0\xdf\xd6\x18\xc4[\xb5n\xf9\xad\xb7ᮡ\xd3\x1b\xfc\xc6m\xc0\xbbN\x1f@\x9d\xa5\x02\xafB\xb5\x14\xb2p\x06\xffPpO}\"\xe5\x10\xbe$\x8cT!\x8c\xf3\xb9\xd2'Zܑ\x16\x04\x80V1\x8fR\x0fD\x9dxl+\xc3\xd0IHI\x99\xd4`\xa9\x8f\x13\x99\x94\xca<\x83\xf2\f̒\"\x8e\u007f\xc8>d\xef~\xe7\x1eC2\xeb\xa8i@\xfe\r\x8fbx+2\xd6\xe6\xc3h~M\xdeƵ\xe9姺\xdd\\\x984\xed\xa7\xd1\xf1\v!\xa9\x16\xbbv\x838q\x03\xfai\xf3pgC\xc9L\x8d\xfdH\xe8\x89\xccg\x03@\xaa\x99u\xea\xe7\xbduh&\x8c\xdd\xd8JXP\x1a\xa4V\xbb\x1e\x15⓺{\xaa\x92\xa2\xebh\x03\x1c\xa91'\x96\xe7{\xa6v\xd8\xde\xd8$\xec\x1d\x94\xe4\x18c\xa4}\xefh\xbdA\xa8iWx\x85\r\x9fŸ4\x1e\xdd3\xb7S\xa7\xef\x98\x1b\xd4ɖ\x17\x9a\x89\x1b\xba\x1e̮s()r\xee\xea;\xf0\xf6\xf9e\xcd\xe2\xf8j\xfd\xe6\xe9\u007f\xf1-\xfb\xf8\xf8̶\xf7\xed\xbf\xff\xd9\xc3/\x1c\xd7\xd3+ͨO\x98{C-P\x1bw\x03\x99\xa6b\xef\xeb\xae9V\xbd\x9fE\xba#ßLn\x9ee\"\xdf\f>\xb5?\xa3}l\xdf\xd2/]\xf1\x96>\fP[Iɥ\xa3\xc8\x14Qҗ6\x89Q\xf6\xa8\x1c\xf2\xaf\xc3\xeb\xfaw\x91v\xf5\x9d{x\xcd)\x9f\xc7\xdf\xfc\xe0\xef\xff\x9cE\xa9\xc8_j\x1c\xf4\xf1\xff\x01\x00\x00\xff\xff\xe4\x1a\x03\xe4r\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96Ms\xe36\x0f\x80\xef\xfe\x15\x98}\x0f{y-\xef\xce^:\xbam\xb3ۙ\xf4#\xf5\xc4\xdb\\:=\xd0$l\xb1\xa1H\x16\x00\x9d\xa6\x9d\xfe\xf7\x0eIɶ\x1c%i\x0fՍ\x10H\x80\x0f>\x88\xc5r\xb9\\\xa8h\xef\x90\xd8\x06߂\x8a\x16\u007f\x17\xf4y\xc5\xcd\xfdW\xdcذ:\xbcߢ\xa8\xf7\x8b{\xebM\vW\x89%\xf4\xb7\xc8!\x91\xc6O\xb8\xb3ފ\r~ѣ(\xa3D\xb5\v\x00\xe5}\x10\x95Ŝ\x97\x00:x\xa1\xe0\x1c\xd2r\x8f\xbe\xb9O[\xdc&\xeb\fR\xb10\xda?\xbck>4\xef\x16\x00\x9a\xb0l\xffb{dQ}l\xc1'\xe7\x16\x00^\xf5\u0602A\x87\x82[\xa5\xefS$\xfc-!\v7\atH\xa1\xb1a\xc1\x11u\xf1Ø\xe2\x9crk\xb2^\x90\xae\x82K}uj\t\xdfn~\xbcY+\xe9ZhX\x94$nb\xa7\x18\x8b\xc3\x06Y\x93\x8dR\xdc\xfaT\xac\xc1\xd7\xc5\x1c\xdcV{P\xf7\x00'݁b\xb8\xf6k\n{B\xe6՚\x82Ff4\xe5\xa8\xea\xf1\xa6h\x17\x81<Fl\x81\x85\xac\xdf?q$\xa2n\xea\xbdnT?\xe3K\x96B\xd8A\xd5\x01\t\xb0\xc5\x01ǹ\xb9\xc1\xd7\xe3\x19/\xd9\x1c#\xd7<\xa1~v\xe0\xc7\xfd\xf9AFI^\xee)\xa4\xd8\xc2\t|\xd5\x1e\x82^\x13\xa6«\xfe\f\xe8\xca_gY\xbe{N\xe3{;hE\x97H\xb9\xf9\x80\x17\x05\xb6~\x9f\x9c\xa2Y\x95\x05@$d\xa4\x03\xfe\xe4\xef}x\xf0\xdfXt\x86[\xd8)W\"\xcd:\xe4\xfbdN\x1c\x95.\f9mi\xc8on\xe1Ͽ\x16\x00\a\xe5\xac)l\xea\xd5BD\xffq}}\xf7a\xa3;\xecU\x15\xcef\xcd\xe4Z`\x19\x14\f\xce\xe5\xd8U\x9f!x\x84@\xd0\a\xc2!\xb0\xdc\fGF\n\x11I\xec\b5\u007fg%{\x94]\x18\u007f\x9b\xbd\xab:`r\x91\"\x83t\bC\xa9\xa1\x01.\x9e\xe7T\x92\xce2\x10\x16R\xbe\x96\xedٱ\x90U\x94\x87\xb0\xfd\x15\xb54\xb0\xc94\x89\x81\xbb\x90\x9cɕ}@\x12 \xd4a\xef\xed\x1fǓ9\xdf/\x9btJƘ\x8f_\xa9E\xaf\\\xe6\x9a\xf0\xff\xa0\xbc\x81^=\x02a\xb6\x01ɟ\x9dVT\xb8\x81\x1f2\x1c\xebw\xa1\x85N$r\xbbZ\xed\xad\x8cMJ\x87\xbeO\xde\xca㪴\x1a\xbbM\x12\x88W\x06\x0f\xe8Vl\xf7KE\xba\xb3\x82Z\x12\xe1JE\xbb,\x8e\xfbң\x9a\xde\xfc\xef\x18\xf1\xb7g\x9e^TM\xfdJZ?\xcb=\xa7t\rs\xddV\xfd?\xe1͢L\xe5\xf6\xf3\xe6\v\x8cFK\b\xa6\xcc\v\xed\xd36>\x81Ϡ\xac\xdf!\xd5\xc0\xed(\xf4\xe5D\xf4&\x06\xeb\xa5,\xb4\xb3\xe8\xa7\xd09m{+<\xa6_\x8eO\x03W\xa5U\xe7\x1e\x92b.j\xd3\xc0\xb5\x87+գ\xbbR\x8c\xff9\xf6L\x98\x97\x19\xe9\xeb\xe0\xcf_\x98\xa9b\xa5u\x14\x8f\xed\u007f6B3e\xb9\x89\xa8s\xcc2\xb8\xbc\xd7\xee\xac.e\x00\xbb@\xf0\xd0Yݍe9!z,\xe0\xe6L<W\xb0\xf9;5\xf5\xa9\xfc\x99\xcbB\x89\x93%\x9c\xe4\xda\x12.ކ\x97(\x94\x17\xe7_q\xa8/\xda@B'\"\xf4\xc7w.w\x81\xb9M\xff\xe4\xeeH\x14\x88/\xef=q\xe7sQ)\x83\x82\xb2\x9eA\xf9\xc7a\x1bH\xa7\x04\x1e\x90r\x8a\xeb\x90r\xef@\x03&]\xf0\x1aPt\xc3s\x98\xc3\x17\xebC\xdc\\hY\xc1\xfe\x897\xcf\xc6!\u007fy\xf8P[\x87-\b%\x9c\x8d\x9f\"R\x8f\x93?e\x9ax\xf1\xd2\xeb\xac1\xc7\x1bk_\xc6׀\x17\xb8>\xf5\x97V\x96p\x83\x0fOd\xa7\x11\xe5ɯ\xe9\xc8\xf2*\x93\x99\x84\xbb\x10\x9df\xba\xf7\xa7\xd50xՉ\xa2\xfc\x00(\xaf\xb39\x03\xcb\x12H\xedGԧ,VZc\x1447\x97\x13ƛ7\x93Q\xa1,u\xf0u\xf4\xe3\x16~\xfeeQOEs7\xfa\x91\x85\u007f\a\x00\x00\xff\xff\xb0\x1aq.\xff\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WAs\xdc6\x0f\xbd\xef\xaf\xc0\xe4;\xe4\xebL\xa4M&\x97\x8en\xa9\x93ΤI]\x8f\xed\xf8\xd2\xe9\x01\xa2\xb0\x12k\x8ad\tr\x1d\xb7\xd3\xff\xde!)i\xb5Zy\x9d\x1e\xaa\x9bH\x10xzx\x00\xa1MQ\x14\x1b\xb4\xf2\x8e\x1cK\xa3+@+\xe9\xab'\x1d߸\xbc\xff\x9eKi\xb6\xfb75y|\xb3\xb9\x97\xba\xa9\xe0\"\xb07\xfd5\xb1\tN\xd0{\xdaI-\xbd4zӓ\xc7\x06=V\x1b\x00\xd4\xdax\x8c\xcb\x1c_\x01\x84\xd1\xde\x19\xa5\xc8\x15-\xe9\xf2>\xd4T\a\xa9\x1ar)\xc2\x18\u007f\xff\xba|[\xbe\xde\x00\bG\xe9\xf8\xad\xec\x89=\xf6\xb6\x02\x1d\x94\xda\x00h쩂\xc6<he\xb0q\xf4G \xf6\\\xeeI\x913\xa54\x1b\xb6$\x12\x86\xa6I\xc0P]9\xa9=\xb9\v\xa3B\x9f\x01\x15\xf0\xd3\xcd/\x97W\xe8\xbb\nJ\xf6\xe8\x03\x97\xb6C\xa6\x04\xb6!\x16NZ\x9f \xbd\x1f\"]\xe7H\x90\xad\x81\x83\xe8\x00\x19.\xe9a{\xe5\x8c fj\xd2\xe9\f\xf0&\x99\xa5\x05\xffh\xa9\x02\xf6N\xea\xf6$\xb6%Qzt-\xf92\x1e<\x8d\u007f\x89=\x81ف\xef\b\x90\xd9\b\x89\x9e\x1a\xf8\x14jr\x9a<1\xb8!\x17\xb3\xe8\xb7\xc9c:\xfb\xaf \xc4\x14\x9fB\xb8}\xb4\t\xc2N*\x02o&\xf2O\x03~\x1aϟ\v8\n\xa5<I\xf2\xcc\xe1\xbbv\x8e\xbcA\x1f_[g\x82\xad\xe0\x90\xebl=h,\xebs\x91\xaf\xb4\xa3$\xfbOk\xbb\x9f\xe5`aUp\xa8Nu\x956Y\xea6(t'\xdb\x1b\x00\xeb\x88\xc9\xed鋾\xd7\xe6A\xff(I5\\\xc1\x0eU\x12\x13\v\x13\xf1\xc7D\xb0E\x91$¡\x1eS\xc6\x15\xfc\xf5\xf7\x06`\x8fJ6\x89\x8b\xfc)ƒ~w\xf5\xf1\xee\xed\x8d\xe8\xa8Ǽ\xf8\x8c0%\x03\xc2\x00l\x9e%@\r\xe8\xbcܡ\xf0\xb0s\xa6\x87\x1a\xc5}\xb0\x83O\x00S\xffN\"\n\xdb8l\xe9\xd5$m\x1c\fA\x996\xe5\xbe\x1c\x8eXg,9/G\xe2\xe33\xeb\"\xd3\xda\x02\xf0\xcb\xf8E\xd9\x06\x9a\xd87\x88\x93\xaa\x87\xea\xa7\x068}mV\xbb\x8c\xc2N\xec\xea\xdcIfn!\x9a\xa0\x1e\x90\x97p\x133\xe0\x18\xb83A5\xb1\xd9\xec\xc9yp$L\xab埓g\x8e\xbcĐ\n\xfd\xa8\x8d\xf1I-B\xa3\x8a\xb9\b\xf4\nP7\xd0\xe3#8J\xec\x04=\xf3\x96L\xb8\x84\x9f\x8d#\x90zg*輷\\m\xb7\xad\xf4c\xdf\x14\xa6\uf0d6\xfeq\x9b\xba\x9f\xac\x837\x8e\xb7\r\xedImY\xb6\x05:\xd1IO\xc2\aG[\xb4\xb2H\xc0uj\x9be\xdf\xfcoR\xc9\xcb\x19\xd2Ee\xe5'I\xffIޣ\xf4\xb3<\xf2\xb1\x8c\xff@o\\\x8a\xac\\\u007f\xb8\xb9\x9d\xbaIJ\xc11\xe7Y'\xd31>\x10\x1f\x89\x92zG.'.\xa9,z$\xddX#\xb5O/BI\xd2Ǥs\xa8{\xe9y\x94m\xccO\t\x17\xe9\xf6\x80\x9a \xd8X\xf8M\t\x1f5\\`O\xea\x02\x99\xfes\xda#\xc3\\DJ\x9f'~~\xe9\x1d\x1bf\xb6\xa6\xe5\xf1VZ\xcdТ\x94o,\x89\x98\xafHZ<'wR\xa4\x12\x80\x9dq\x80\x87\xca\x1eh+g~\xd7j3\x81J\r\xfaxm\xd9\xe6s\x0f\x97\f\x0f\x1d\x1e\xb7\x90\xffSٖ\xb1\x0f\xf0\x00!w\x86\xefʅ\xbf\xa7\xa2\xafit\x15\xc3(\xd5\xf8\xe9\xfe\x89kg\x194>\xa4C\xbf漀\x1f\x12\xd2Ϧ=\xb3{a\xb4\x8f\x82>cr\x17\x87\a\xba\xd1h\xb93g-\xc7\xd1h\xba[\x96f\xd7\x14[-=\x05iؾ&\x0ej5Ъ\x10\xc7'ݞϱ\x9cf\x8a\x81e=\x9b/\xeeO\x87\nx\x90\xbe\x83\x87N\x8an\xc5+\xa4c)A\xb1\xbfL\xe3\xc9Z\x8a\xce\xc0\x8e:\x96\x8eN\xe4Q\xc04\x90\xcc\x17\xa7Ai\xe9|Qs뎋\xa1\x16\x9e\xad\xd84\xc1}s\xcd\xe6\xb1p U\x04\xe7HO\xc3b\xbc\xad\x96\a\xbe\xa5hG\xc5\u007f\xb9\xfe|\xb6r\xdf\x1f\xecҤ\x8dRg\x1c\xd6Q\xc1\xb2\x8dwk܋\xb5\x9b*kI@~\xe6w\xfc\xb3Y\xa3\xafV\xba\xd9\xc8\xf2\x04\xb4\x0f\x93Yn,\xa4\xf3\x15\xb1\x9c^\x92;\xe2t\xed\n\xd4'\xd8j\x82\x86\x14\xc5ѷ~̝\xf1\x91=\xf5K\xbc;\xe3z\xf4yb,\xbc<\x11J\xfc\x8b\xc0ZQ\x05ޅu\x15\xad|l\xfa78\xfb\x9dW\xd1b-\xfdSq\x9d\xc9?<\xd1\xc1\x8a\xf8{q\xb2v\xfc\xbb\xf1,\xfa\x15q/\x96\x0e\xbf_o\x0eo\xc3\u007fR\x9e\xc6\xd3\x06@\x9at\x9b\x19u\xc3\xcc8\xac\x1c*\x06\x85 멹\\N\xe7/^\x1c\x8d\xdb\xe9U\x18\x9d\xffԸ\x82_\u007f\xdbd\xaf\xd4܍8\xe2\xe2?\x01\x00\x00\xff\xff\x9cДê\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs#\xb7\x11\xbe\xf3Wt\xad\x0f\x8a\xabġ\xbdN\xa5R\xbc\xedJٔ\x12[\xab\x12e]\xb6\xf6\x00\x0e\x9aC\x843\x00\x82Ɛ˸\xfc\xdfS\r`\xc8y\x91\xa2\xec\xac3\x17\x89x\xf4\v\xfd\xf8ИL\xa7Ӊ\xb0\xea\x19\x1d)\xa3\xe7 \xac\xc2/\x1e5\xff\xa2l\xf3Wʔ\x99m\xbf_\xa2\x17\xdfO6J\xcb9\xdc\xd4\xe4M\xf5\x88dj\x97\xe3-\xae\x94V^\x19=\xa9\xd0\v)\xbc\x98O\x00\x84\xd6\xc6\v\x1e&\xfe\t\x90\x1b\xed\x9d)Kt\xd3\x02u\xb6\xa9\x97\xb8\xacU)\xd1\x05\x0e\r\xff\xedw\xd9\x0f\xd9w\x13\x80\xdca\xd8\xfe\xa4*$/*;\a]\x97\xe5\x04@\x8b\n\xe7`\x8dܚ\xb2\xaep)\xf2Mm)\xdbb\x89\xced\xcaL\xc8b\x1ed\x902\b&\xca\a\xa7\xb4Gw\xc3\x1b\xa2@S\xf8\xc7\xe2\xe3\xfd\x83\xf0\xeb9d䅯)\xb3kA\x18\x84\x95H\xb9S\xd6\a\x91\x1e\x8c\x84\xe7\xc0\n\xde\a^\x10\xd7\x03\xd5\xf9\x1a\x04\xc1=\xeefw\xfa\xc1\x99\xc2!Q \x10e\\\x84ua\xc0\xef-\u0381\xbcS\xba8\xc1\x9e\xbcp\xfe\xa0\xeeP\x0e\x9e\x82\xdd\x1a5\xf8\xb5\"\x88z\xc3N\x10\x84\x9d([\x9co\xd8zi$\xb2\x96\xc2〱\xc5<\xb3Ff\xbc\x89\xac\xc8G\xb4\xbfo\xa6\xc0\xac\xc0\xaf\x91\r\x1f\x0eS(\xadt\x11\x86\xe2A\x807\xb0\xc4 \x17J\xa8mK\x9c\xfb\x0e\xfd\xb3\xb6h\x8b4.\xcd\xef\x11\xe4\xc1\xc8\xcbD\x88\x94\xce\v\xf0\"\xb7\xe7#\x91\x17\x19:\xb4\xe6N\xa2\xf6j\xa5\xd0\r\x19?\"y\x95\x03/#\xe5\x8dۃ:\xac\x86\x95qm\xa7h\x89\x90\xb6=\xa25\x97\xc9\x11),\xbcq\xa2\xc0\x1fM\x1e\x82\xf0\xbc\x1dRT\xa4=\xd0lb_u\xd8qVZ\x9b\xba\x94l.\xf2\xc6u<\xb6\xbf\xfbEi\x9bl\x93\r2E\x8b\xea\xbb\x02\x871P8S\xdb9\x1c\x13F\\\x9d\x12ULr\x0fF\xc6\xd3{\u007f\xb4h\xa9\xc8\xffsl\xf6GE>\xac\xb0e\xedD9LNa\x92\x94.\xeaR\xb8\xc1\xf4\x04\xc0:$t[\xfcYo\xb4\xd9\xe9\x0f\nKIsX\x892d$ʍm\x87\x11\x1b\x8e\xea\xa5K9\x98\xe6\xf0˯\x13\x80\xad(\x95\f\xb6\x88\xaa\x18\x8b\xfa\xdd\xc3\xdd\xf3\x0f\x8b|\x8d\x95\x88\x83\xcc\xccXt^5\x1a\xf3ת\x01\x87\xb1ޑ_1\xa9\xb8\x06$g}\xa4\x18\x06q\f%P`\x13\xddB\x11\xfb*\xab\xa5\xfd\xf1@\x9bϬ@h0\xcb\u007fa\xee3X\xb0\xea\x8e\x1a\xf7ȍޢ\xf3\xe007\x85V\xff9P&\x8e5fY\n\x8f\xc9\xe4\xcd\x17\x12\xbc\x16%\x1b\xa1\xc6k\x10ZB%\xf6\xe0\x90y@\xad[\xd4\xc2\x12\xca\xe0'\xe3\x10\x94^\x999\xac\xbd\xb74\x9f\xcd\n囪\x97\x9b\xaa\xaa\xb5\xf2\xfbY\xa8]jY{\xe3h&q\x8b\xe5\x8cT1\x15._+\x8f\xb9\xaf\x1d΄U\xd3 \xb8\x0eE/\xab\xe47\x87\xe3\xb9jI\xdas\xe9\xf8\x05\x9f;iw\xf69P\x04\"m\x8b\xf2\x1f\xcd\xdbd\xbfǿ-\x9e\xa0a\x1a\x8e\xa0k\xf3`\xed\xe36:\x1a\x9e\r\xa5\xf4\nS\x16Y9S\x05\x8a\xa8\xa55J\xfb\xf0#/\x15\xea\xaeѩ^V\xca\xf3I\xff\xbbF\xf2|>\x19܄\xda\xcfA^[\x8e8\x99\xc1\x9d\x86\x1bQay#\b\xbf\xba\xd9\xd9\xc24e\x93\xbel\xf86d\xe9.\x8c\xd6:\f7\x98b\xf4\x84z\xe9`a1\xe7\xf3b\xa3\xf1>\xb5R)#r\x9e\x16\xfd\xe5Y\x8b\xecXh\xf27\x9a\x95\xbbKz2\xbd\x1f\xdb\xd1H\xa5[ٻI\xcdqe\x8f$@\xd9\xcf\xe6\xecx\x83RD)\xa1g\xbd\xfd\xa3F\xe7O\x1b\x89g\xe5\xbf7\x12\xc7\xc4\xe5\x8d\xe0\xd7\"\xfa$c3\xce4\xb5\x0e\x18\xc0\xe8\x8b\x05\xb0F\x9e\xe5\x9f(\vp\xb8B\x87:\xc7&\xf9\x9c\xc3\x1d\x03\xf3\xb5\x91A_\xb6S\x87\r'\xf3\xf1\xa8\xa4\xef\x1e\xee\x9a\x1c\xdc\x18)\xc9\xec\xfb\x1c\xcfZ\x84\xbf\x15\x17\x9eP`_\xe2zu\xb7\x8alBF\xf2\x06\x04X\x85\x11&\x1eR;(M\x1e\x85\x8c\x83#$\x018p\x1d\xa6\xf5\xd71\xff\xa44w,\alk\x10\xb1\xbe\x05\f0\xfb\xbb\x89\xb2\x8e\xd2\x14y\x8e\x14`\xb1\xc7\n\xb5\xbf>@u\x89\xa4\x1cJ\x06\xe6\x98UB\xab\x15\x92\xcf\x12\at\xf4\xe9\xed\xe71\x9b\x01|0\x0e\xf0\x8b\xa8l\x89נ\xa2\x95\x0f\t\xb5q\x10E\xd1\x10\az\xb0S~\xad\xc6\x15\x17\xecHI\xe1]Pԋ\r\x82I\x8a\xd6\b\xa5\xda\xe0\x1c\xde\x04Xv\x14\xf1\x17\x8e\x86_ߌ\xd2\xfcS\f\xd27\xbc\xe4M\x14\xecP3\xdbAt\x140F\x92SE\x81\r\x1e\xeb\u007f\xa1\x10p\x82\xfd\x16\x8ccݵi\x11\bd\xf9\xccb\xa2C9\x10\xf8\xd3\xdb\xcf'\xa4\xed\xda\t\x94\x96\xf8\x05ނJ7\x1ck\xe4\xb7\x19<\x05\x8f\xd8k/\xbe0\x9f|m\b5\x18]\xeeǥ5\xb0\x16[\x042|[²\x9cF\xac\"a'\xf6\xac\u007fs\\\xeca\x02\xacp\xbe\x8bFF\xa9>}\xbc\xfd8\x8fR\xb1\v\x15!\x93r\x95[)\xc6\x1c\f6b\xe5d\x9f\f樣sx\x03\xf9Z\xe8\x91\xc4\n\x01\xb4\x04\xeb\xaej\xaee\xd9\xd5k\xa3\xb5\x0f\x1b\x9ao\x04>\xf4\x13\xc3\xff\xa9\b_\xa4V\x80\xee/\xaaվ\x81\x9cUkS/\xd1i\xf4\x184\x93&'V*G\xebif\xb6\xe8\xb6\nw\xb3\x9dq\x1b\xa5\x8b);\xe24z\x02\xcd\u00ad`\xf6M\xf8\xf3\x9b\xb4\b`\xfd2U:w쯩\x0f\xf3\xa1٫\xd5ip\xe5\xa5U\xe9j\x91\x90O\u007f'\x87\xc4n\xad\xf2usI8f\xcf\xd1\x18\xa9\x84\x8c)W\xe8\xfdWw[6d\xedX\x9e\xfd4u\xac\xa6BK\xfe\x9f\x14y\x1e\u007f\xb5\xe5juA\x90\xfe|w\xfb\xc78s\xad^\x1d\x91\xa3\x808\xfaD\xbbgq\x16N=v\x966\xc0n\x04I\x1e\xd6\\\x8c\xe4\xbc(\x06\x00\xaa\xdd\xfa;\r\xb2\xce\xe8\xdc\xed\xbc\x89\x82@8\x04\x01\x95\xb0|N\x1b\xdcOc\x91\xb6Bq\x8d\xe52z\xecn\bkK5RNS)Np1!o\xbe֊\x82\xc6\xf5\x1d\xb1z\xdc}\xd6ک]9\x02\x9f\x13\xeb\x88K\x0e\x10\xba\xdd\xc2\x1a\xfa\xef\x00\xb8\x9e\xb0\x1b\xdf\x02\x19]\xb5E\x9b\x8e_]:+\x18\xd2w\x06\xac\x91\x9d\xdf#\xbd\xb1f\xaaէ;c\xb6\xd8a\xbd\xf8\xfe\x16ۻ\xc9z1\x1f\xf8\xa6\xe9\xcbX\xe1\xb7\xdc\xe0r\xc3ر\xdb\xd1>w\x847\xc3\xf5\xa1!\xe2d\x14˫\x8a\xfd\xb1\xd5\x05N\x1c\x86\x970h\x11\x8b\xfbB\xdeeZ(\x03\xb4cԹ\x12\xaaD\tM;\xbd\xbfg@\xb3Mc\x89+NU\xb5-\x8d\x90ͥ(\x89\xd64y\x9e\xf86\x1c\xfa\rWt\x92bM(\xc3-yD\xfd~yX\x19W\t\x1f\xbbz\xd3\x11\x82\xba.K\xb1,q\x0e\xde\xd5\xfdɓ\xa1_!\x91(·\xd7OqM\xbc\x1f\xa6\r \x96\xa6\xf6\x87\vb'į(y\xcf\xe5\xb7ӑ+X\xd7e\x05\x03fJ\xf0\xb1,ÎvX\x1f\xdf[\x82<K\xe4c\xf9\xbd\x11\x0e\x10\xdeH\xceK\xc6+Ƃ球\xceD\x0f\x84\x1ba]\xf59L\xe1\x1ew\x83\xb1ޣK{\xea\xa6\t\x86\xc1̇\xe0\xe7\x17\xeb\x9b\x18\x9cW9-\x82\xb5)\x9b\xf04^\x94\xa0\xebj\x89\x8e\xf5^\xee=R7\t\x0f\xef\xfc\xe1\x16q4Zkw\xd3B\x88tҥ(\x17:t\xd98f\xbc\x01\xa9Ȗbx+jT\bH\x82C\x86C\xfa\xe8\xadM\x98Zta\xea5]\x8a ͭѣ\x18\xb7\x89O\xa5\xfd_\xfe|\x12q(\xed\xb1\xe8$\xf54\xcb\x06|\xcf\xf4\xff״O\x16V\xd2\xc2\xd2\xda\xf8\xbb۳\xa7\xbd8,k\xbc|\xf0\x12\x83\aZ\xadǪ\x81\x98\xd1\r.\x0e\xbd\xee\xfb\xe0y\x11;K_\xa8\x1b\xe9\xf50\x83\x05Z\xe1\xe2\x13I\xcf\xda\xceT\xf1I\xb1\x9d\x8f\xaf\x81Th\x8b1\xf6\x89`(^u\x89\xcb\tC;㢯\x0e)v\nA'\xf1wE\xff#r\xfe\x88?\U001063af\xd3\xdf\x1f\u007f\xa5g\xe4\xf8\xce\x14&\x92Z\xb2\xc5<uU\xd3\xc8\x11\x86\x88\x9c!;\xca\xfb\xfe\xbbӛ\xd8*i\x1e\x92\xc2\xcf\xdc\xe8\x88fi\x0e\x9f>O \xf5Z\x9f\x1b9x\xf0\xbf\x01\x00\x00\xff\xff-\xbc\x85&\xc9\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x8f\x1b\xb7\x11\u007fק\x18\\\x1e\xae\x01\xacU\xec\x14E\xa17\xe7\\\x17\xd7&g\xe1t\xf1\x8b\xe1\aj9Ҳ\xda%\xb7\xe4\xacd5\xc8w/\x86\xe4\xfe_ɺC\x9d\xee\x8b-r8\xf3\x9b\xe1\xfc\xe3\xdcl>\x9f\xcfD\xa9>\xa2u\xca\xe8%\x88R\xe1\x17BͿ\\\xb2\xff\xabK\x94Y\x1c^o\x90\xc4\xeb\xd9^i\xb9\x84\xbbʑ)\x1eљʦ\xf8\x0e\xb7J+RF\xcf\n$!\x05\x89\xe5\f@hmH\xf0\xb2\xe3\x9f\x00\xa9\xd1dM\x9e\xa3\x9d\xefP'\xfbj\x83\x9bJ\xe5\x12\xad\x97P\xcb?\xfc\x90\xfc\x98\xfc0\x03H-\xfa\xe3O\xaa@G\xa2(\x97\xa0\xab<\x9f\x01hQ\xe0\x12J#\x0f&\xaf\n\xb4\xe8\xc8Xt\xc9\x01s\xb4&Qf\xe6JL=\b)=2\x91\xaf\xac҄\xf6\x8eO\x04Ds\xf8\xc7\xfa\xc3\xc3JP\xb6\x84đ\xa0\xca%e&\x1cz\xb4\x12]jUI\x1e\xd3c\x90\x00\x81\n\\\x95f \x1c\xdc\xeb\x955;\x8b\xce-\xeeLQ\xe6H(\xfd\xe1\x00p\xed\xa9\xfd\x02\x9dJ\\\x82#\xab\xf4n$\xba\xc44)\x8dL\xf8\x94+E:\x01\xe0\xa1\xde\x02\xb3eŽ1\x85\xd2J\xef\x802\x84`\b \x03\x1b\x84h\x8f.\x94\x87\x1e\xeb\xab\xd1L\x03y!\x86\x95\x91\xd7I\x0f\x8c\xce\xcbn\x05E)\x1d!\x1f۳\x17\xe5\xc4\xeb\x8eחlN\x84\xee\x9d\xd1SR\xabb\x83\x96\xe5z\xa2)\xcd~\xf2\x1b\xcd\xf1\xe7\b&C\"\xf7\xe7ǒ\x9fx\x0f\xf4@\xfeY\xfb\x06\xf2\x96\xd7\x150\x1c\tKM|M P\x05\xc21C\xed/8\xca\x04S\xa2\xf5\x91\tG\xe1\xc0\xf3\x18\xfa}\xb3\x12@HAx\x06B\x1a\x02\xa7\x1b\xe7/ÑND`?*\xcfc\xa9\xf3V2\xca9\x1dfow8f\xb3\xb3\xa6*\x97\xd0f\x9e@\x1dS^H\x97+#\x83[>v\xdc5W\x8e\xfe9\xb9\xfd\xb3r\xe4Iʼ\xb2\"\x9fHt~\xd7)\xbd\xabra\xc7\xfb3\x80ҢC{\xc0_\xf5^\x9b\xa3~\xaf0\x97n\t[\x91\xfb\xf4\xe6RSv\xb3\x02\xdb\xc7U\x1b\x1b3\xba[\xc2o\xbf\xcf\x00\x0e\"W\xd2\xdb#\xa8cJ\xd4oW\xf7\x1f\u007f\\\xa7\x19\x16\",\xb20\xbe\tR\xb5\xd6\xfcu*J\xb36\xb8\xd4[f\x15h@r\ra\xe7\xe6<\x12\xd6P\x82\xf3b\xd8\xf5)S\x1cy^-\x1d\xaaJ\x87-0\x89\xd0`6\xff\u0094\x12X\xb3\xeaց\xcbL\x95\xfb<u@K`15;\xad\xfe\xd3p\xf6\xc1\xc4\"sA\x18\x8d^\u007f\xbeZh\x91\xb3\x11*|\x05BK(\xc4\t,\xb2\f\xa8t\x87\x9b'q\t\xfc\xc2^\xa9\xf4\xd6,!#*\xddr\xb1\xd8)\xaakhj\x8a\xa2ҊN\v_\tզ\"c\xddB\xe2\x01\xf3\x85S\xbb\xb9\xb0i\xa6\bS\xaa,.D\xa9\xe6\x1e\xb8\xf6%4)\xe4w\xcd\xf5\xdcv\x90\x0e\xa2<|\xde\xef\xceڝ\xdd\x0e\x94\x03\x11\x8f\x05\xfc\xady\xeb\x84\xfe\xf8\xb7\xf5\x13\xd4B\xfd\x15\xf4m\xee\xad\xdd\x1es\xad\xe1\xd9PJoц\x8b\xdbZSx\x8e\xa8ei\x94&\xff#\xcd\x15\xea\xbe\xd1]\xb5)\x14\xf1M\xff\xbbBG|?\t\xdc\xf9N\x82\x93^Ur\xd4\xc9\x04\xee5܉\x02\xf3;\xe1\U0001b6dd-\xec\xe6lү\x1b\xbe\xdb\x00\xf5\t\x83\xb5\x9a\xe5\xbaA\x99\xbc\xa1aFX\x97\x98\xf2\x85\xb1\xd5\xf8\xa0ڪ4$\xbe\xad\xb1 F\xf4I\x87\xf1Tp\xf2\xb7\x11\xe9\xbe*\xd7d\xac\xd8\xe1\xcf&\xed\x84\xf9\x19T?M\x9d\xa8a\xe9X\x9b\xf9\xff\x815\xb8@9`\t\x90\xd7G\x8f\x19Zl2\xbaJٕ\x8cSd\xec\x89ن\x02\x97\f\xceO\x9a\xdd+j\xe4E\xf8+\x13\x9d\xde\xe2\x16-\xea\x14\xeb\xe8\xbf\xd4ˌ\xd0wj\xef\x10\xda9S\xc3\xd9|8\t\xf4\xed\xea\xbe\u0381\xb5E#d\x1aJ\xbch\x10\xfe\xb6\x9c\xf8}\x91\xfb\x9a\xd4\xdb\xfbm\x10\xe33\x02\x19\x10P*\f\rg\x93ZAiG(dX\x9c`\t\xc0\x81õ\xd9ӿ\n\xf1\x1f\xd3L\x9b\x8e\xd9\xd4 B}\xf1ux\xf1w\x13\xb0N\xf2\x14i\x8a\xcew\x1a\x84\x05jz\xd5t\xe0\x12\x9d\xb2(\xb9\xd3Ƥ\x10Zm\xd1Q\x12%\xa0u\x9f\xde|\x9e\xb2\x19\xc0{c\x01\xbf\bn\x0f^\x81\nVn\x12Z\xed\x1f\xca\x05C4\xfc\xe0\xa8(Sӊ\v\xf6\xa3\xa8\xf0\xd1+Jb\x8f`\xa2\xa2\x15B\xae\xf6\xb8\x84\x1b\xdf\xe3\xb6\x10\u007f\xe3\xd0\xf9\xfdf\x92\xe7\x9fB\x88\xdc0\xc9M\x00\xd6Ԭnĵ\x00)\x13\x04d\xd5n\x87uw8\xfc|\"\xe6\x04\xf7=\x18˺k\xd3a\xe0\xd9\xf2\x9d\x85<\x83r\x04\xf8ӛ\xcfg\xd0\xf6\xed\x04JK\xfc\x02o@\xe9`\x95\xd2\xc8\xef\x13x\xf2\x1eq\xd2$\xbe\xb0\x9c43\x0e5\x18\x9d\x9f\xa6\xd1\x1a\xc8\xc4\x01\xc1\x19n\x011\xcf\xe7\xa1W\x90p\x14'ֿ\xbe.\xf60\x01\xa5\xb0\xd4\xef\x06&\xb9>}x\xf7a\x19P\xb1\v\xed|\x1e\xe3*\xb3U\\\xf3\xb9؇\xca\xc5>\xe9\xcdQ\x05\xe7 \x03i&\xf4DZ\x03\xdf4x\xebn+\xae%\xc9\xeds\xa3uX\xb6\xebo\xa2|\x0f\x13\xc3\xff\xa9\b^\xa5\x96o\x9f\xbf\xaa\xd6Cǟ/\xaa\xb5\xaf6h5\x12zͤI\x1d+\x95bIna\x0eh\x0f\n\x8f\x8b\xa3\xb1{\xa5wsv\xc4y\xf0\x04\xb7\xf0\x9d\xf9\xe2;\xffϋ\xb4\xf0\xcd\xf2u\xaa4\xaf\xf5o\xad\x0f\xcbq\x8bg\xabS\xf7u\xd7V\xa5\xdbul<\x86'9$\x8e\x99J\xb3\xbaIo\xb3\xe7d\x8c\x14B\x86\x94+\xf4電-\x1b\xb2\xb2\x8c\xe74\x8f\xf3\xa7\xb9В\xff\xef\x94#^\u007f\xb6\xe5*uE\x90\xfez\xff\xee\x8fq\xe6J=;\"'\x1b\xd2\xe0\x13\xa5\xb9\x97l\xbe\xadB{\xb1\x9bz\xec\x91\xd6]\xe0D\x1f\xd7\xd0\\\xdd\xc89-J\x97\x19\xba\u007fw\x11\xc1\xba!\xab\xa5\xb7&\x8f\xed[\xcdi03\xb9\x1aI`s\x11E軧\xba\xe0\x88!t\f~\x85;\xd0\x17!\xe1\xe7\x10\xb79]$\xf3\xe9\x0e\xbeGQ\x1a\xd9\xfbݿ\xdf\xdeVk\xf4\xderg\x14\xd7E8|\xcc\xf8Q\xce\xf5ϙ0E\x8d6\v\xf1I\xf5l\x95k\xf7\x8b\x1e4\x13\x83\xa4\x8b7w7\xa6\xf7\x13\x02+\x03.R\x05\xfa\xd7B\x186\xf5FL㦢\xe5\x16\x0e\xfaL\xc8\xccP\xfaf\x8b\xfb\xc0\xadP9Jh\xc6\xd5\xf0\xc4\xef9\xffd\xbe\x1d\xe7ʚM\xe5P\xfaw\xde\x04\xe0ᩭ\xb1\x85\xa00\x9c\x9a3\x83\xc1\xbe\xae\xf2\\lr\\\x02\xd9j\xb8y6\f\ntN\xec.\xc7\xc1/\x81&\xbc\xb0\xe2\x01\x10\x1bSQ\xf3Ċ\x01\x11տu\xf1Ư\u007f\xe0e\xc2]\x06\xb1b\x8a)\xbfj\x82\xf2\x92c\x81\u007f\xbdT\xc5P\xc4\x1c\x1e\xf08Zkg\xff\xa3\xad\xfeԱ\xbb\xf3\xde{\xc0\xd5\nG\x01\x97u\x8eD\x90\x99\xbc\xf6\xdcɹq\xb4@\x1d\xe8\xe3\x17\xaa\xefy[\xbb\xb5\xe7\xebl\x15\x18\xc5\x0e>\x15ڏd\xd8;ɀT\xae\xccŸ\x85\xafu\xf0e\x8f\x9d\x93#\xa4\xf5\x8b\xd1(\xf79o\xeafh?U\x8e\xebPP\x9a\xfe\xf2\xe7\xb3\xf5Qi\xc2]/\x15\xc6\xddf,\xff\xbf\xe6}\xb6\xf8\xf6g\xf1\x97K_\x8f\xf4kY+\x0e\xe8\xc79\xab\x9b~\xc6\xe9\xa6/\xe4\x8f\xc84\x13\xa6\x19,\xb5\u007f$|\xdd\xfe\x8a\u007f\xcc\vCz\xbf\x01A-\xd9\x11\x1e\x87Qq\xa5-X\"\xe5^\v\xe5\xc3ph\u007fsӛ\xc1\xfb\x9f\xa9\xd1\xe1ωn\t\x9f>\xcf \x8e\xa8>\xd68x\xf1\xbf\x01\x00\x00\xff\xff\a\x89\x1b\xd1P\x1d\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xe36\x0f\xbe\xe7W\x10\xfb\x1e\xf6\xf2\xc6\xd9\xc5^\n\xdf\xdai\x17\xd8vf:\x98l\xe7R\xf4@\xcbt\xa2\x8e,\xb9\"\x95iZ\xf4\xbf\x17\x92\xec\xc4q\x9c\xec`\x81\xfa&\x8a\x12\x1f>\xfc\x10\xbdX.\x97\v\xec\xf4\x13y\xd6Ζ\x80\x9d\xa6?\x85l\\q\xf1\xfc\r\x17ڭv\xef+\x12|\xbfxֶ.\xe1&\xb0\xb8\xf6\x91\xd8\x05\xaf\xe8{j\xb4բ\x9d]\xb4$X\xa3`\xb9\x00@k\x9d`\x14s\\\x02(g\xc5;c\xc8/7d\x8b\xe7PQ\x15\xb4\xa9\xc9'\v\x83\xfdݻ\xe2C\xf1n\x01\xa0<\xa5\xe3\x9fuK,\xd8v%\xd8`\xcc\x02\xc0bK%xb\xd1\xcaS\xe7X\x8b\xf3\x9a\xb8ؑ!\xef\n\xed\x16ܑJ(\xea:AC\xf3\xe0\xb5\x15\xf27΄6CZ\u008f\xeb\x9f\xef\x1fP\xb6%\x14,(\x81\x8bn\x8bL\tnM\xac\xbc\xee$\x81zL\xb6\xe0q0\xb6\x87\xac\x0f\x1c\xd4\x16\x90\xe1\x9e^V\x8f\x84\xf5>\x9d\xcd\x00\xd7I%\td\xdfQ\t,^\xdb\xcd\x05\xcb\x06Y\xee0b\xb4h\x15E\xaf\xcfq\xdc\"\v\x88n\tڣ*\xbc \x83\x0fvd:\xe9\x8dn\x1b\x81\xa8Q\xe2r\xe3]\xe8J82\x96\x8f\xf6\xb1\xcaq\xce^\x1f\x9dN[F\xb3\xfc4\xbb}\xabY\x92Jg\x82G3\x17\xa1\xb4\xcd\xdan\x82A\u007f\xa6\x10\rt\x9e\x98\xfc\x8e~\xb1\xcfֽ؏\x9aL\xcd%4hR\\X\xb9\xe8\xc4}\x84ڡ\xa2:\xcaB\xe5\xfb\\\xe4\x12\xfe\xfeg\x01\xb0C\xa3\xeb\x94=\xd9\x1fב\xfd\xf6\xe1\xd3Ӈ\xb5\xdaR\x8bY\x18\x8d\xb9\x8e\xbc\xe8\xc1\xed\xf8\x8dj\xe1 \x9bD\xe1m\xbc*\xeb@\x1d\xb3\x9f\x18dK\xd0\xe70\xd5\xc0\xc9\f\xb8\x06d\xab\x19<%\xb7l\xae\x87ѵ\x10UЂ\xab~'%\x05\xac\xa3랁\xb7.\x98:\x96̎\xbc\x80'\xe56V\xffu\xb8\x99A\\2iP\xa8g}\xf8R\x9a[4\x91\x84@\xff\a\xb45\xb4\xb8\aO\xd1\x06\x04;\xba-\xa9p\x01w\xce\x13h۸\x12\xb6\"\x1d\x97\xab\xd5F\xcbP\xfdʵm\xb0Z\xf6\xabTú\n\xe2<\xafjڑY\xb1\xde,ѫ\xad\x16R\x12<\xad\xb0\xd3\xcb\x04ܦ\xe2/\xda\xfa\u007f\x87\xf0\xbc\x1d!\x9d\xd4D\xfeR\xe2]\xe4=\xe6\x1dh\x06\xec\x8fe\xfcGz\xa3(\xb2\xf2\xf8\xc3\xfa3\fFS\bN9Ol\x1f\x8f\xf1\x91\xf8H\x94\xb6\r\xf9\x1c\xb8ƻ6\xddH\xb6\ue736\x92\x16\xcah\xb2\xa7\xa4s\xa8Z-1\xd2\u007f\x04b\x89\xf1)\xe0&\xf5@\xa8\bB\x17ˮ.\xe0\x93\x85\x1bl\xc9\xdc \xd3\u007fN{d\x98\x97\x91\xd2/\x13?nݧ\x8a\x99\xad\x83x謳\x11\x9a\xb6\x84uG*\x06,\xb2\x16\x0f\xeaF\xabT\x03\xd08\x0fx\xa6_\x8c.\x9e+\xce\xf8U\xa8\x9eC\xb7\x16\xe7qC\xb7N\x8d\xca\xfc\x02\xaa\xef\xe6N\f\xb0b\xd7˅J\U000ca4db\x01d\x8b2\xaaPAm\x0fe>\xe3\xc7E\xca\x13\xed\xc7\x1e\xfd1\xe5\x8eU\xfb\xab\xbe\xdc\xcd\x1c\x88\xael\xdd\v\xb8FȞ\xbc\f=ʊΜ\xf0\xc1\xbe\x1adnӟ\xea\x98Z\x8d&\u007f\x15\xe0\xe3Dy\xe0\xb9\t\xc6\xf47-\x95k;\x14]\x19\x1a\n\xb9q\xfe\f\xa2\xcew\xecsU\u007f\x1d\xbf\xbb\xf8\xdc\xd3Ṹ\x8a\xfc\xe9Tw\x9c YЃH\xa3\x80?}\x15\xc7_\x9f\x13\f\x9d\xab{\x00}\xd2r\xf4\xf3\x95\xd8cp\xb5\xa7\x93n\xb8\x9cO\xfe\x13\x8d\xb9\x8c:Q\x98F\xf3ds\xc2\xd7\x17\x9bA\x9a]^\xdf\x0e\xf2\xd0\xd4\x13\xab\x82\xf7de\x18\xa5\xe2K\xf8U\rafr\xba\x1a\xe7\xdbs\xfd\x01\x92\xb96_M\x03\xd78ߢ\xe4\x89j)üv\xfcⴊ\x95\xa1\x12ć\xe9\xe6\xe5\x8e@̸\xb9\xee\xc1]\xd6\xc9Oa\u007f\x00\xb0rA.\x10\x9b\x1e\xc5+\xd4^E\x94f\xe2\xabx\x1e\xa2\xc6\\X\xe9\xb5\xc6Ɇvjb\x19\xa7\xea3\xd9q\xca>\xd1t2\xb7q\xc1\xa7\x99\\\x9e\x88\x8e\xbf#\uf3eb\xfe\xaf!\x8f\xd8i\x03 \r\xab\xf5(Ĝk\xb3\x97\x1c\v\x04\x95\xa2N\xa8\xbe\x9fN\xd9oޜ\f\xcdi\xa9\x9c\xcd\xff-\\¯\xbf-\xf2\xadT?\r8\xa2\xf0\xdf\x00\x00\x00\xff\xff\"c\x04\x9d\xba\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\xbd}s\xe46r0\xfe\xbf?\x05J\xe7\xfaI\xcaiF\xbbw\xc9U\xa2J\xfd\\:\xad\xec\xd3c\xafV\xb5\x92\xd7ϕ\xcfq0d\xcf\f\"\x12\xe0\x01\xe0H\x938\xdf\xfd)4\x00\xbe\xccp^\x00JZ\xd9G\xa4*g͒M\xa0\xd1\xe8\xf7nЂ}\x02\xa9\x98\xe0g\x84\x16\f\x1e5p\xf3\x97\x1a\xdf\xff\xab\x1a3q\xbax;\x01M\xdf~q\xcfxzF.J\xa5E\xfe\x11\x94(e\x02\xef`\xca8\xd3L\xf0/r\xd04\xa5\x9a\x9e}A\b\xe5\\hj~V\xe6OB\x12\xc1\xb5\x14Y\x06r4\x03>\xbe/'0)Y\x96\x82\xc4/\xf8\xef/ތ\xff8~\xf3\x05!\x89\x04|\xfd\x8e\xe5\xa04͋3\xc2\xcb,\xfb\x82\x10Ns8#\x12\x94\x16\x12\xd4x\x01\x19H1f\xe2\vU@\x82\xdfNS\x9c\x10\xcdn$\xe3\x1a\xe4\x85\xc8\xca\xdcNdD\xfe\xcf\xed\x87\xeb\x1b\xaa\xe7gdl^\x18Ohr_\x16\xd74\a\x9cg\n*\x91\xac\xd08\x1b\xf3+\x11Sb\x9f!Z\xf8ϒ\xa9\x149>og\xf3g|\x00\u007f\xd0\xcb\x02ΈҒ\xf1\xd9\xda\a5ե\x1a\x17s\xaa:\xbe\xf6\xd1\xc1\xb6O\x11U&sB\x15\xb9\xe27R\xcc$(uz!\xf2\"\x03\ri\xe3ӷ\xf8\xf4\xbe\x9fV\x9aJ]\xe1t}\x0e\xe6\x9f\xc8\xc3\x1c8\xd1s\xa8V+\n\x90\xb8\x1b\xe4\x81*\x820V\xe7P\xfdb'\x91R\r\x1b\xa6\x90\xd8E4\xf76n\x1eI\a6\xda\x18\xda9\x17\x90RH\xb5\xfe\xf9\vQrmv\x9ef\x19\xb1\x0f\x91\x19p\xf3uHIZ\x1a\f7g֘\xc1e\r\xd2~ސ\xe0\f\xe4\x86\x19<P\xc9\x19\x9f횃\u007fl\xdfY\xfc\xd0\x04\xbbu\x1e\xfeԎ\xd7N\\\x03\xdc\xf9\f\xd6\x11:\x93\xa2,\xceH}\x00\xed\xd3\xee\xc0[f\xf1\xb11\xb3\x8c)\xfdm\xf3\xd7\xef\x98\xd2\xf8/EVJ\x9aՇ\x1a\u007fT\x8c\xcfʌʳ\xc6\xf2\n\t\n\xe4\x02\xbe\xe7\xf7\\<\xf0\xaf\x19d\xa9:#S\x9a\xe1\x81R\x890\xf33\xc7V\x154A*P\xe5D:^\xa5\xce\xc8\xff\xfc\xef\x17\x84,h\xc6R\\\xab\x9d\xaa(\x80\x9f\xdf\\}\xfa\xe3m2\x87\x9c\xda\x1f7\x1cN\xa6\b%\x9fp\xc9\xc4\xc3%zN5\x91\x80\xb3\xe3Z\xe1\x9eТ\xc8Xb\xa9UL\x1dHR\xbd\xa3\x90\x85\u0530j\x16C\x89\xa6r\x06\x9a|[N@rРH\x92\x95J\x83\x1c;0\x854'A3\x8fk3\x1a\\\xbc\xfame\r\x87f\x91\xf6\x19\x92\x1a\xbe\rv\xaa\x8e\xfbBJ\x14\"\xc0\x10\x9d\x9e3U/\t\x97\xd1\x00K\x90.9\x11\x93\xff\x82D\x8fɭ\xd9\x14\xa9\x88\x9a\x8b2K\r\xb3_\x804(IČ\xb3\xff\xae +\xb3@\xf3Ɍjp\x9b\xef\a\xb2jN3\xb3=%\x9c\x10\xcaS\x92\xd3%\x91`\xbeAJހ\x86\x8f\xa81y\x8f[§\xe2\x8c̵.\xd4\xd9\xe9\xe9\x8ci/\xb7\x12\x91\xe7%gzy\x8a҇MJ-\xa4:Ma\x01٩b\xb3\x11\x95ɜiHt)\xe1\x94\x16l\x84\x13\xe7(\xb6\xc6y\xfa\xbbj\xb3\x0e\x1b3]\xe1\xb2v \xb5oĻ\xa1zK9\xf65;\xff\x1a\xbd\xfe\x1c\u007f\xbc\xbc\xbdkR\x15Sm\x9c#\xb6\x1b\x84V#\xde \x8a\xf1)H\xbbqH[\x06\"\xf0\xb4\x10\x8ck\xfc#\xc9\x18\xf06\xd2U9ə6;\xfd\xf7\x12\x94!]1&\x17(\xbd\xc9\x04HY\x98\xb3\x9e\x8e\xc9\x15'\x174\x87\xec\x82*xv\xb4\x1b\f\xab\x91A\xe9n\xc47\x95\x8e\xf6\x83\x16[\xd5\xcf^;\xe8\xdc!w\xbao\vHZ'üĦ\xfe\x18O\x85l\x1d~\xf3ʸ\x01\xb2\xebX\x9aQ\xab\x18\xed\xdfW&\xf1\xe7\xea1C+\xe6\xf3%g\u007f/\x01\xb9\xaa=\x93\xb0\xce.\x9a\xbc\xbf9\f\t\x8cW~\xedĠ\x19\xf0\x98de\ni\xc59\xd5֙^\xae=\x8e\xfa\x1de\xdcи\xe1\xf3f\xba\xbc\xfeWd\x90\xb4c\x96\x86\xce\x18\xb7\xd0\bkI\xfb\xd5\xc93\r\xf9ڴ\xb6\xac\x89\xa0\xc2H'\x19\x9c\x11-\xcb\xd5o\xdb\xf7\xa8\x94tى\x8a\x8f\x95\xd0\xd8\a\x13\xd5\xd3\xee\x98g,\xc1-\xab\x0e3\"\xe3ׄ\x87\xb9\x10\xf7\xdb\xd7\xfe\x17\xf3D͍H\x82\x86\x01\x99\xc0\x9c.\x98јp\xb5N$L\x80\xc0#$\xa5W\xcdZP\xad&#$)\x84қֽ\xe9t\x91\xa6T]\xff\xa7\x8d\b[[\x8fc\x02~+\xcd\xf2Z\fAp0s\xcc\r\U000eb7d5\xa2\xb4Ϫ\xce/\x90MX \x13\xaa %\xc2\xedu\x99\x81r_J\x91\xd1ԧ\xe7d\x03\xe0j\xd1VVft\x02\x19Q\x90A\xa2\x85\\\xc5\xden\x1cڱ\x9b\x13l\xc0^\aOp\xdc\xd3\xf1\xd2&;\x10\x1ba\x12\xf20g\xc9܊1C\x83\b\x85\xa4\x02\x14\x1e\x12\xa3V-\xbb\x17G\xb6\xef\xb5\x1d[\x8eI=\xb6\x1e\x98UX\xebG\xa7\x1e;\x99I=v\xb0\x956.k-\xf2\x1f\x06\x95\x9e;\x06\x13\xe6\xd5ڋOI\x98\xa8\xe6\x1bU\xf4jJ /\xf4\xf2\x840\xed\u007fEu\x1e\x9d\x16\x1b\xd1S}\xfbW\xb7\x11\xa14}\xb5\xfa\xde\x13\xd2t\xcf]\xa8>\xfd\xab\xd9\x04d\xf6\xb7\x8e\xd7\xef\xb9\x01\xdf5\xdf9!lZm@zB\xa6,\xd3 Wvb\xdbr\xc5\xf6\x9d苂ݒʌ\x9c\xead~\xf9h4\x10U\xfb\x1a\xf7\xc2\xc6\xea\xabVq\xf3\xbak[\x98n\x85J\xd0xb\x12rk\x92\xdd!\x06\xeb_\x8c\xbeGί\xdfA\xba\x19)d\x1f\n[[\xc2\xf9\xca4\x9b\x9fuz\xe8~\vpJJ\xa5\xc3[\xf3\xfa\x84Pr\x0fK\xab]\x18c\x1f\xbdoB\xe2\xc3;!J@\x1b\x1f\t\xea\x1e\x96\bę\xed;\xde\xddo\xeb\xed\xb8\x87\xe5\xee\x87V\xd0ff\xe3\f,\x8b?\xf3\x03\"\x00M\xbe}QF\xd0\xe9\xe29̮E\x91}Y\x84\x1f\x1e\xdb\xc1˫\xb6\xa9\xe1\x90\u008d<TvS\f\xb5\xcfY\xb1\xd7\x02\xd1\x1f\xa5\x00τw\xba|\xa2\x19K\xab\xcfX\xfa\xbe\xe2'\xe4Z\xe8+\xbeIYm\x8f\xcbG\xa6̴xJ\xde\tP\xd7B\xe3/O\x8eD;\xe5`\x14\xda\xd7\xf0\bqˆ\xcd\xfa\x9b\xbe\x9b\x9dDlǕ5ګ-a\x8a\\qcDX\\Y\xef\x9b\xfd\xd86n\xdf\x1ey\xa9\xd09\xc3\x05\x1f\xa1\xb0\x1bw}ǡxOBn\xee\xc2\xfa\xb4\xaaO\xda\xcf\xed\x05\xf1\xce\xc8\x05\xfb\xb6\xf5$f4\xa9\xbd\xd6\xe8\t\xa3\x1af,!9\xc8\xd9fA\xd0\x1c\x85\xe1\xd9\xfb|~/^jG\x10=\xed#\x9a\xfdp\xcc8\xdd5\x8d\x919\x9b;\x9f\xf1[\xbb\xe3\xc1N\xd7\xd7\xe6\aw\xad\x03\x85$\xea\r;\xb0ٌ\xb9\xed˽\xf7\xc6\xfc\xbaܶS\xb22.\xa7\x859\x9d\xffcD\x15\x12\xed\xff\x92\x822\xb9\xf3\x84\x9ec\x84!\x83֛\xce\xf5\xd2\xfc\x88\x81\xcf\x141\xbb\xb9\xa0٪\x03\xb5cY\xc2p\rȬ\x18\x16\xd35M\xe3\x84<̅\xb2Rq\xca K\tۦi\x99qp\x0f˃\x93\xb53~p\xc5\x0f\xacx^;\xb1^\x96\xef\x00,x\xb6$\a\xf8\xe6A\xbc\xea\xb2\x17\xd5\xed\xf1\x10\xefp\x91\xd6c=4\xcbj\x03\xae\x8aY\x18Ut\xf3l\xf7\xa0\xb9B(\xfd\x97.\xe7׆\x99\xdc\xf8\xe7\xdb\x1ad\x877i\x87e\xe3<C\x15\x8b4Z\xd7T\x83t\x0e1\xcb6\xbdn\xde\xc3R\xd9\xe5\xf4\xaa\x1c^\xb4\n\xbc\x1a\xa4n\xa5\x00\xeb\x1a\xdf=\xb9\xfd\xb5;\x83\x8d m\xf8\xf2\xb1\xe1\xab3'\xd0\xfc\xdd\\\xc0Sꝉ\xc8s\xcaw\xf2\xf6\xb5I^\xd8\xf7<\xe5:0v\xaf\xe5\xac\xc4S\xb7\xafb\xe6\xe9\x05\x83=\x0fL\xcf\x19'\xd4\x1f|\x90\x8ex()ĺ˵k̩\"\x13\x00\ue476\xe3\xd0\xdb\xf1|\x926g\xfc\n\x81\x93\xb7O*\x97I\x8d\xa2\x88\xed\xf3ȭ6\xb0\xfa\xc1J\x8e}\x91\xfd0\a\t-\x1aXw\x11\xa3^ǅn\xd8\xe9\xfb!\xda\xce\xe3P\x91)\x93J7'\xa9H\xa9\xf6\xdbؠ\xdd23\xbec9\x88R\a\xe3\xf4\xb2~\xb7\x15{\xcb\xe9#\xcb˜\xd0\\\x94;\x85\xae\x1dF\x06\xb0\xbc\n\x929\x8c>P\xa6\x91A\x19\xa8\xe8\xa9ТJ\x1e\xd9\v\xee\x04\xa6\x86\x89$\x82+\x96B\x95va\xf7\x89\ts즔e\xe5zТk\x84\x99\x81\x1c\x13J\x82\xb1\xfa\xc1\xbe\xd7\xf0\xb1\xcd\xc5C\x1b1{.}N\x17@ؔ0M\x80'f/@Z\x06\x8b\x1fpH@\x94\xec\xd4c\xec؇\x19\x9b\x01\xbc\xcc\xf7Y\xf8\b\xcf%\xe3[\xdcI͇\xbf\xa6l\x9b3Џ\xa0m24\x16{\x00~\xa8\xdf}\x81\x03P3\x83\xad\xcaH=&@>\x02M\x97\xfe\x14P\xad\x8d\x19\x88;.\x88,y\x93\x8b=1\xfd\xefoC\xb9\xef?\x95y\xc48۹\x91+\xdem\xa6\x9bڇ\x01\xf0lڇ\x01^\x89\xa2p\xf7\xc6U\xebu#\x14\xbcҊ\xb3\xae(doMd\x02\xc6\x00\x84Ժ\x8b\nQ\x99\xf96\xb5\xa43\x9c۹\xae\xfd\x95\x89\x15G\xa83\xe5\x9aIW\rB\xdf\xc7_i\xc7R\x94\xe4\x81r\xedI\xbbR\xab\n\xb1\x17m\x87\xed\xa3\x1dT\xce\xf6~v-\xa3\xcb+\x8d>\xb1\n\xb8\x96KL\xf9\xd9o\xbav\x18\xc3/\x15ɽQ\x11r:\x83\xc3CE.\u07bf\xf3\xfa\x82a\xff{sw;\x98\x8d1\x16R,XjT\x99OT2:Ɍ\x819\x05\t<\x01E\xbe<\xfat\xfe\xf1\xe7\xeb\xf3\xf7\x97\xc7\x01\xa0\x8dQ\n\x8f\x05\xe5\x86\xe2J\xe5\xa5q\xb5\xdff\xf2\xc0\x17L\nnP\x13\x82\x87\xab)\xa1d\xe1g\x9aTyPư\xc9\x16\x90\x9e\xb8\xf8\x88[A\b>,\x9bd\xbc(\xb5\xf7$>\xb0,\xc3,+\x9e\xcc)\x9f\x19,\xdd\xcdC\x806\xf0GԒk\xfah\xe6\x8c*\xa4Jh\x01)\xd2/\xa1\x01 SQ\x9a\xa5\u007f\xf9\xe5\tapF\xbel|bL.\x1d\xd4z\v\x03 \xe3j9,@Z\x1d\xd7n\xe0\t\x910\xa32\xcd@)Á\x1e\xe6\xa0簟\xd3\xd2\x0e\xeb\xfap[\x06\xde\xebi\xa8\xaf+\x93-\x00pG\x96\xdb}\x95\x929f\xe24\x15\x89:\xd5TݫSƍH\x19\xa5T\xd3Q\x83\t\x9dZ\x890r\xd2i\xe4m\xbcQE\xac\xa7\xbf\x93%\xe7\x8c\xcfF\xb4z\x8a\xf1\x11\x1d\xa99d\xd9\xe1\xde\xd3\r`\x9d\x0ema\xd6X\xf3\xa5\xfd]\xd5A\x86\xb2\x1dm\xfevY\xb13\xfb\xd51\xb9\x16zs&\xd1\xe6Q1r\xc4븓\xe3]^\xdf}\xfc\xeb͇\xab\xeb\xbb0F\xd7d\x91\x9b\x19_\x00\xccn\x16\xd9\xc1\xf8\x02\x8f\xc9F\x16\xd9f|\x01Pw\xb2H\xc7\xf8\x828\xe5N\x16\x19)8\xb6\xb1\xc8\x06\xe3\v\x99\xeb\x1e,\x12\xd7\x10\x00s`\x91\xff`,\x12\xf8\"\x92=~\xe7\xd4\xf6\xc6Q\xae\xf69D4k\x811^\xc6\xdb\\\xa2\x17q\x04c\xbb\xed\x14\xe2\x8bO\xb4\x1d\xc2\xe6\xcde\x06\xc0%5\xe9\xfbLU\x14\x04\x95\x05\x14B\xf0\xe1ڽ\x1d\xdb#\x1bݣ\xb3\x14\xcd\xea\xf8qx \r\\\x8c\xc9{\x17ӥ\xe4\xe2\xe7\xabw\x97\xd7wW__]~\fA\x06\x89=#ć\xe6{\xa1\xe4\xf0\xe9L\n;6\x18\x16\x85\x84\x05\x13e\x95\x9e\x1b\f\xb7\xf3x\xae\x9d\xb6\xf0\xe9b\xe0`I\x14\xc8\x05K\xa0\xfb3\xa1\xfb\xb9\x87\r\x14\f\xb1K!h\x89\xf9`\x88O\xaa\x16ر\x87r\x10\f\xf3\x19\xac(;v\xdbR\xc1 k\xc5b\x83\xba\x10\f\x11Ջw0\xa5ef\xfd\x13\a\a\xe3\xfd\xa5\xb5\x1d\xfd\xd8\xcb\xd7R\xec\xe5@n\x8e\x16\x8b\xb9\xb5\xc5\x1b\xdew\xfa\x14\x8c\xf7Х\u05f5\x84\xab5 \"`f%x\x8b# 7\xa7\x1e\xb1\xf2\x8c\xd80ڔ\xcd\xde\xd3\xe2[X~\x84i8\x80Udc\xe6\x9dKV\xc3\x02\xc3\b\x88\xc4\xc8u;\xadp\xd6\xd7\x0f\x1fd\xff|ĮѮ\u007fvY\x93\xa8\x99\x19\xb4\xc4,\x86\xf49@~\xc4h.~\xb4\xc5uS\x85q\xbc/zY\xfb\x9a\x1e\x89\xe0\t\x14Z\x9d\x8a\x85\x91\x92\xf0p\xfa 佱%\fg\x1f\xd9H\x80:\xc54\xfc\xd3\xdf\xe1\xffD\xcf\xe8\xeeû\x0fg\xe4<M\x89@6Z*\x98\x96\x99M\xf1\x89\x90\xc3~ԅ\xbd'XfzBJ\x96~\x15\xcaH\xfd\xe8M\x0f\xa2\xb0y^OB\x13\xb7\x18\x9d\\F\x98\xb4\xedaH\xaa:\xf7ƴeZ\xe1\xf9\xc9K\x15Ϊ\xfd\x98@\xb4\xca禅Ȟ\b\x91\x01\xe5\x110\xf6\r\u007fu\x8d}\xd2\n\xbb\xc6\xde!\xb2\xae\x81\xb4\xfe\x14\xb2\xe0\xb0\x16\x066EN\x84KGR\xa7B\x9c\x11U\x16\x85\x90ZU\x05\xc3cs\xd8\xc3uYҨ9\x1eW\xd5;'\xe4?\xab\x1f1\xa7\\\xfdxx\xf8\xef\xdf^\xfe\xf5\xff?<\xfc\xe9?\xe3\xbeRCl4S\xe9\x0f\x16\x9b\x9ep\x91\x82a\xc7'\xf6OgA\x9c'\x18\u07bf\x8eF\x8c\xebi1\x17J_ݜ\xf8?\v\x91\xae\xfe\xa5\x82\x15A\xf2\x04¹\xbbEB\xc8hѨo\xa5`EZ4\x97q=\x17\f\xa5b?\x8b\x1b\xaa\xe7F\xa7{\x90Lk\x88a\x1bv\x18;\vd\xae\x88\x98\x9e\x18>V\xabዷ\a\x9fM\x9d\x98\xfa%>\xc9\x16 \xae\x9cJ\x81\x90\xe3%\x88W\xb4\xbc}Z\xe5\\E\x83<\xbf\xb9\xf2\xad5>\x13\xba\xfbɏj\xab^Z\x8a\xf84ү\x9fA\x9ax\xd8q\xbaϴ\xed\xb29\xb3\xf9\xd3\xfb\xd4\xcbm\x1e\x19\xc3\x0e\x1c\x94\xa7u\x17\x8e#\xfb\xe38)\xca8N\xec\xde\xcf!\x17ry\xe2\xff\x84b\x0e9H\x9a\x8d\x94\x16\x92\xce\"ټ\x9f&N\xaf\xfe\xcb~,\x8e37\x16\xbf>\xcbpg\x0eq\u07bc\xa4\x94\xc6\xcaȖ^\xfeC\xfaY$OE1]M@\xf6\x1dm\x92\xaeSQ\xfbXh5\x8f@'\xc7Bde\x0e\xea\xa4\xd2\xf2\xa3\xc1\x1ah\xc0\x17dA\xa5\xfal\xb6J\xca\x16L\xed\x97<\xd95(_~\x88b>\x04\xf9\xe7jۭ>Pz \xa1\xd3\xe4\xf2Eע\xd4E\x19o!M\x85̩\xae\xe2\x12\x8f\x85P\xe8\xd8\xf4\x8d)\xa2\x01\xb7\xf4\x95\xb7\a\x91p\n\xaa5H~F\xfe\xe3\xe8o\xbf\xffet\xfc\xd5\xd1яoF\xff\xf6\xd3\xef\x8f\xfe6\xc6\xff\xf8\xa7㯎\u007f\xf1\u007f\xfc\xfe\xf8\xf8\xe8\xe8\xc7o\xdf\u007fsws\xf9\x13;\xfe\xe5G^\xe6\xf7\xf6\xaf_\x8e~\x84˟\xf6\x04r|\xfc\u0557\x91\x13~\x1c\xd5>\x8c\x11\xe3z$\xe4\xc8n\xfd\x8er\xe9m\xc3o\xc7\xd3\xf0\x9d\x8f^\xa7\xe8'JIS\xe7\xfaL\f\xa2\x9fz\xd4c\xf9\xbd\xb4#\x05\x89\x04\xfd\xba|\xaevN\x8d\x1a\x88CU\xb7\xb6\xf8\r\xb8a\xfb\x9ax\x16=\xb5\x8d\x81\u0379\b\x86`\xfbx\xa7\xa8me\xe8\xe1\xdfC\xb0\xffߏ\xc1M<\xb8\x89\x9b\xe3\xb7\xeb&\xbe\xb5ge\xf0\x11\u007f\x1e\x1fq\xe4\xab1\xab\x1c!S\nI\x83\x8a\x99[T\xbeWX`\xba3\xe7\xabn\x96D\nQ\x94\x19ձ\xf1\xe9\xcd))c/\x00cr_\xea\x8c[\x1bD\xcf{\xe7\x1b\x9dg\x19a܊<\x9c\x94O\x03\x91`m{B\x15\t:D\xb0\x00\xae\xeb>ӭBIls\xcd\xf8lL~\x98\a\xb9a\xad.\xe5\xf2&\x18'y\x99iVd@\xaav}U\xb5~\bT\xa5D°\xf33&\xa5\xd8\xf65J{\xf4\".4\xbd\x0f\x81YHH \x05\x9e\x00vu)\x1b-\b'KB9\xb9\xe4\v\xfcZ\xd0\xea\xd3\xd2&wZթ\x9aW\xebk6\xf7!\x00\xecgIA4\xc7ԥ\x80\xb4\xbb;\a1=\xb7AF\xb9\xf6\xadt\xaaXe\x88\x1a\x11\xab\x14Wy\x1a\x11\x06Ú6\\GY+m6<J\xe8\x9b\xe7\xef?\xfa\x18\x04\xb1\xaa\xe9s\xa9\xa5\xafK%}\x06u\xf4\xe9T\xd1^jh\x1f\x15t\x9b\xfa\x19m\n\xd6g\xc7\xcb\xc2xձ\x8f\xda\x18\xad\xbe\x15\x12\xa6\xec\xb1\x17\x0f9\xe7վ\x10\x96\x02\xd7l\xca\"4z\xa3\xf5H(\x80c\xcd)\xd0dnۺ\xf1v*H8\xfd~\xe6\xachk\xc9?\x05\xa3\xbe\xed\xf29\f\\wົ\xc7o\x8b뺃\xf0\xabd\xb9/d\x91b\x05dl\x89\xe6\xbbF\x15%\x9e\xfa\xe6\xfd\x10\x01k\xdd\xe7T֭\tN\xf1{!\x87\x0f\x1b\x12\xfa~k\xb5\x10\xb2́\xc5\x03\x99\xb3\x99!\xb3\f\x16\x10\x12\xf6\xb4\xda5\xc9)\xa73\xdb\xf2M\v\x1f\xbe\"B\x12\xc3H$K\x83\x8a*k3\x14\x17iĚaC\x99\xa0i\xe3\"\xad\x90\xc5g\xec\x1e\xc8;(2\xb1t\x9d\xddx\x8aWG\x19\xb6s\v:$!+\x82=\xe0:n\xca,\xbb\x11\x19K\x02|\xf3mR\xbbB\x1a+\xca,#\x05\x02\x1a\x93\x0f\x1c\xe5\xc3y\xf6@\x97A\xf1\xc6kX\x80<!W\xd3k\xa1o\xaci\u05eeV\xb0 \x03 \xb2)9\xb3\xf7\xda\x10Mg\xe8B\xa8\xfb+\v\xd9\xfaT\x00X\x14\x10\x0fLA\xe7\xc5,/w\xd4~\x87\xdf4\xa2\xd0\xfe\xfd\xac\x04\x93\xb1)$\xcb$\x8b\xe5J\xe7\t\xa6K\xd6m}\x1b\xe7S-\x95\x86\x10Uȵ\xd1A'\x06\xc3\xf6h\x85\xe0\nl\x1b)\u007fT\xab\x19\x87\xba\x9fT\xaf2\xcb8\x15\xad\x10J\xe3\xa5maR\xba}\x1ao<\x10C\xea\t\xcd2H\t\xcbsH\x19Ր\x85\xfa\x95}\xb7\xba\x96\x0f\x0e\xef$s\x8d\xd0\xc2\xe5\xff\x9c\xf24\x03\x89\xbd\xb9\x9c\u05ed\x05]\x83\xcc\x19\xa7a\x8d\x04H\x95\xae\xe4\xee\xc1#4I\x84L]?$\xdf\xf1\x86\xcaP\xbfH\xc5\xd1P\xdbi\xd0\xebj\xd6Y \xdcI&\x92{EJ\xaeYV\xb7@\xf3\xfd\xcf\xdc%Z\x810\xf7ף\x1bl\xa4\xfa\xcfQuVFx\xcb\xcc\xe9\xef\xea\u007f\xc2\x1f\u0094\xd6x+e\x9f\x1e\x93\xebc\xa5\xcf\x1a 9`\"\xa0\xe0\x10\x1f*\x9e\n\xa3\x86\x182\xaa;\x01V\x02d\x8cm\xf2\"\xa0\xb6\xefX\xa0\xc8\x16\xb1W\x10\xbd߫%S{\xf4\x8b\xcb\a\xf7\x02i\x8e=\xdahFF\xe02ơ\xd9O\x93a\x97\xbf\xf6\x99\x8b\xcdd2@\x9c\x05IR&\xb1\xb3\xfc\xd2\xd7\x13F\xc2\xf4\r#\xb1\xaf\xb6\x10\x9a\x1c\x1d\x9e\x1e\x1e\x877\xdah\xc3\xf4\x9dA\x8c\x8e\x9c\x81\x95\x91\xa1\xfd\x88\xbafi\xd4 \x96\x17\xd9\x12\xf1{\x98\x9e\x10\x16\x1bmu\x85\x8e\xb2\xe4~\x8f\\;\x97\x13\xa2\xf6\xebe\xb7>\xb4\xa4\xbes\xb5\x85e@kYZ\xfd!\x12\xe8\xd1\xe1/\x87'\x04trL\x1e\x04?\xd4H\x02cr'\x8c\x9d\x1f\t\xb3Z\xeaR\x94\x84\x83m\xb6\x06\x8fE\xc6\x12\xa6\x83\xa5\xad\x1fFl\x13Qj\xdb>\f/\xaa\xc2\xf68\x97\x8fѻ䮯\x15S\xf2\x06ϧ\x15\xe1\x84*\x92\xb1\x05\x9c\u0381fz\x1e;_CQ\\\xf0\xd1\u007f\x83\x14\xd8z\x87;xq>\x93\xe0\bQs\xf4Α\b7\xd4Wߍ\n\xc1\x1b\xb1\xfd\r\x04\xaa~d\xed\xf6\xb7\xbb\xbb\x9bo@\xb7\x05L\x04\x1a\xccl|\xee7\xbauAN\x85\\\xbb\xbap\xf7\xe8'\x9b\xe6BE`\x84\xac߉\xa7\xb4\xedGn\x8d\x03\x1e\x13\x1f\xb3C\x8bvَˬ#W7\xb1IB\u007f\x15\xa5\xc1҄N\xb2e\xd5\xe5P\x81&\afڱI\xb6\x8c\xe3\x1e\xfe\x05h\x8a\xdd$\xb9\xd2@\x83\xba\bգ\xe7\x91j\xcc\xe3)\x94\f{\x9f\xe1\xdc-l\xcfv\xa9\xeb\xa3\xd1Z\xc7\xd1\xf9\x18O\x8f\xf5;\xc5\xca\x18\t\x85e\xacn~\x9f\x81\x01\xae\xf1\x03\x8b{\xf7\xfb\xa4G\x8e\x1c\xf5\x97I\xdaŹ\x1e\xa3\xa5\xeaQ\x8dŸE\xba9\x00\xd13뛗JzfJ\x92\xaeH\x8f\xc5Q\x0f\x88\xae*/4]ju<A\xa5Bd[\xa0\xe6x>\xf4\x84f쬎'\xc0O\x9fd?\x12\x93\x12\xd7~\xb9\x0f\x06z弓\x9e\xda\x12\x96\x82D\x96\x9c\xae\x17\x9cjAh\x92`7\xbe\xd8\xf2\\#\f\x90\x1d\xe1u\xf6A-\xc8\x1a@\xfa\x11T!B\xfd\u007f~\xf4(\x8cz\x8a\xb2\xa8'(\x8a\xea\xe8\xad&\t/\xf3\t\xc8\xd8V\x03\xbeـ\xd4-\x02Yɨ\x8c\x04}m\xa7惘^\x9d\xa0|ϛ\xb3\xd6\xc7[3\xcb?\xfd˿\xfc\xf1_\xc6\x16\x01U~f,M_\x9d_\x9f\xff|\xfb\xe9\x02\xfb\\\xc5-\xf4\x19\ua7f0\xbc>R\xa2\xb4\xe3\xd1\b\xc8`\xadT\xd8\x12*\xde\xd5b\xac\x02\xe7/\xb6\x0eYՈ=E\x9b\v\xc8P>\x03'\x89\x17J#<./i\xfbꤸ\x15\xc9}o\xeb\xf7\xf0\xee\xe2\xc6\x02\xaa\r\xe0\b\xccS\xee]\xb2\x8c/D\xb6\xb0w<\xdd]\xdc bb\xf6Ҽ\x8b>tt\x95-\xcd\xfc|\xe5\xb3M:\x89\x80\xc9\xf2\xc2\xddfF\x89\x04\x9a1\xa5Y\x82_\x8a\tz\xf9af\x19\x9e\x9d\xf2*\xac\xfc\xc3\x0f>ɥ6\xf8㏭c\b]\x06\u007f\xac\x99b\xdd\x04q\xc5?\x83V\xf1DZ\x85\xd3&\xa4\xbf\x9fn\xd0*b\xc6k\xd4*~=\x12/\xf2\xc5B\u00ad\x16E\xaf\xec\x00\v\xe2Ir\x03\xfc\xcdC\x9b\xc2\xf7$\r\xdeD{K\xe7\xf9\xcdU\xe5{\x16\xad\xa0;\xa6f\x04\xc2Te2\xf7q\x0e\x0eJ\x9db\x1a@YX\x9f\x93\xbf\",4\x94XH\xc0\xfb\x96\x04?\xa9j\xce\x11\x11\xc0폠\x93\xd0s\x81~\x11\x97\x1d\xe1\xa2j~\x93\xfa%\x1b$\x92\xaa9`wyxd\xf5u\xe8T\tnÞn\xd3X\xb0\xe9\xcc\x14)\xa8R6\xf0\xa5\xeb\x05\xd8O܈\xf4\xf00T\x05kL\x86\xcc$M\x80\x14 \x99H\t\xf6AK\xc5\x03'\x13\x98\xed\xbeEuu8z5\x93\xf4\xc7\xc0h;\x80\xd1\xd0\xeav\xbf@\xa0\x1f[\x97\x00\xb8\xe6\x1d\x89\xa8\xf3\xa3\x1d>B髝\x16\x83\xe5ZH\xfc%Ͳe}\xc8\x02\xa1\xba\xea?]m\xcd:\xb2C\xcf\x01n͋\xe7\xc7\x18R\xc6\u007f\x8b@\xebF\xfa\xc2\x1b\xb1i2\x0f\xa7\x82\xc04\xf6!\xfdf\xdf1\xa4\xdfl\x1dC\xfa\x8d\x1fC\xfa͐~3\xa4\xdf\f\xe97C\xfaMk\xbc\n\xc7ܐ~3\xa4߬\x8e!\xfd&x\f\xe97\x9bǐ~\xb3u\f\xe97[Ɛ~\x13>\x86\xf4\x9b\xb5\xf1[\v\x94\r\xe97\xbf\xd5@ِ~\xb3\xdf\xcbC\xfa\xcd\xce1\xa4\xdf\f\xe97C\xfa\xcd\x1e\xdf\x1e\xb4\x8a!\xfd淭U\xfcz$^\x8f\xfeMA/\xf9\x8c\x93\x1b)&э\x9cn06\xcd\x12\x97\xae\"\xa6Q!u?\x95q}\xc1z\xa3O\xaf\xef\x99\x11t٭\xbd\x84ۧ\xd0t\xf6K\tmb\xb1\u007f\x04\xdd7^R\xa7\x85\xb0\xff\xaf\x8e\x9f7\x02\xe7֯\xb5?ˏ\x13\xa4\xe1\x11\xf3}\xa2\xe5u\xec;4\xe1iS\xa4<Z+\xeb\x1b%\x8f\xd7O\xa2\xa3\xe3\xcf\x13\x19\u007f\xae\xa8\xf8ֈx3\xb6\x1d\x01{-\x1a\xbe)\xae\x1d\xa3X7f\xf7D1\xed\xad\xf1\xecfd:\xc6\xec]\x8be\xafE\xa5#\xa06\xe3\u061d\x11\xe9\b\x98u\f{S4:\x02\xe8\xe5#\xd3\xcf\x17\x89~\xc2(tt\x00\xa6\x97\xb2\x1a\xebK\x8d\xd4C\\\xe2\xe9\xdd\\\x82\x9a\x8b,\x90ǵ\xf8\xdb{\xc6Y^\xe6\xe6`+Øآ\xcak\r\xe5\x18\x9e\xe7X\xc9nCL\x06,K\x01\xaf\xa3\xa3,\vo̅M\xc4\xe6\x14-yU&\t@jdR\xa3\xaf_ \xc4?\x8e\xab5W\xb7\xed\xbf\r\xa33{I\x1aZG\u007f\xfcC\xc4~\x87[UQ)\x06\xbb\xd3\v\x10n \xfe\xfa\xa6\x16\xc4\v\xf48g\xc3s\xa4\x13lI% \u007f\x15e\x8c\x95\xbf9\x8d`%! F.Ʀ\x10\xf4\xe0\x89\xbdR\a\xb6\xa7\r\x18\xdcDaac\xca@\x15\xfc\x8fq\x81Ŧ\vDK\xaa\xe7I\x13\u061c\"@X\x9c\xaf\xa1_z@\xdfԀ'\xbb\xbf\xac\x8ey\xf7\xbc\x91\xba\x8fW\xb3\xaf'\xadW\x1a\xc0\xf3\xa0\xa3\u007f\xf0\xfb3\xdd\x13\x19\xb9\x8f\xf1\xe1\xfe^\xa1\xfe\xf80\u007f\\\x88\u007f{x?\xd2\t\xdf+\xb4߃X\xe2\x9c\uf44e\xf7\xbeN\xf7\x9e\x0e\xf7\xed!\xfcȍ{\x06G\xfb\x16';\xba\xcb#@v;\xd8\xfb\xbaʟ\xd8M\x1e\x1bx\xdf\x1eto\x84ϣ\x14ᎀ{|\xe8<\x9a~\xe3\x18zD\xf0 \x92\x153\xce4\xa3\xd9;\xc8\xe8\xf2\x16\x12\xc1\xd3@\xadf\xe5\x12\x95\xeaT*\v\xcc\xda\xc9\x11\xaeٺNpN\xdd\ry\x90\xfarG\xef\xf9\x0fe\x9a\xa8\xf2\xe1u\xfdv\xdd+}\xed?\xa7\x97\x9e|\x16\xf3\xdd\x16\t\xf6\xdf\xf8\xbf\x88\a\"\xa6\x1a89b\xdc\xef\xfdq8\xcfs\x86{\xed\xad\xa9\x0e\xaf9\xbbo\xdfx\xd0\xc1\xb5\x8c\xbf:\xc7\n\xba\x94\x94z.O\x9a\x03\xffԮ4\avZ\x86z\xb2[\xee4\xeb\x90k\xf3\xed\xc0\r\xab\xaf\xd7z\x8bs\xf6\x1c\x03=\xba\xaeX\xfe\xb7OD\x91IP;\x13\xa0\xeat\xa6@\x14v&?\xb5S\x99\x02!v$>u\xa71\x05\xc2m%=E\xa40}Vo\xe2\x13\xa5-mOY\"\x85\x88\xb1\xb1\xa3ҕ\x06Ki\xaf\xb1=-i\xb0\x94>\xaf\xa5\xf4\xdam\x01\xcdr\x10\xa5~5f\xc0Ü%\xf3\xa6\xb6\xc1rPD\x94\xf1)\xd4F\x8fpS\xea\f\xb6=\xef\x055\xbf!\xcb!\x82\xc2\xc2\xdc\xde\x1d>\x9f\x95\xde+u\"P\xc0z\xa9\"\x94\xbc\xbb\xbe\xfd\xf9\xbb\xf3?_~7&\x974\x997[=qB\x03\xc5\x1a\xf2\x9a9]\x00\xa1\xa4\xe4\xec諒\x99\x90\x1cU_9~\xa1;\xc8#$\x87\xe1,\x01\a\xbd\xb5)\xdf1\x85\rq\x10\x86kQ \x14\x84^\xfeږ%\xe4\xd2\x00\xb1\xfa!ʝ9H 3\xb6\b2T\fL\x9b\xffChZ5}0\a՜\x12&8\xa1\x13Q\x06\xb1\xc69\x10\x0eڜ\xe0\xca/%\xb8j\xf5\t+\x15\x04]\v8)\xf1:\xb3B\xb2\x9cJ\x96-\x9b\x13\xa4٘\\\v\xafq/\xc3t\x81&\xea\xde}\xb8\xbc%\xd7\x1f\xeeH!\xb1ՒͶ\xc1\u007f\x0fܨ\t\x98m\xb1\x9b\x9c\x8e\xc99_Z0\x96K3E\x8c\x9a\r<l\xaaN\x99\xf0\x97X\x1e\xbc\x19\xe3\xff\x1d\x98}\x93F۰\xe9RA\x8bO֒A\xad\xe6\xc2&\x99\xa5\xce@=\xc8\xed{\xaf\xbb\xf3\x82C\xaa+\xa9~nE7\x06\xe1\x12\n{\xb3\xa3\"4\x88\xd5{\x02\xc6mCVgNZ\x16\xa9\xcb\xc5\x1a8Is1\xbd\ue7ae\xb5\f\xaf\xa2Z\xea\f\xd6\xf2\x1c\x15\x16\"=T\xe4\xea\xc6\x13\xdf\xd8^\xe4j8|0H\xbc\xd7{A3\x96\xda\xc9\xd9p\xc5\tyC\xfe\x9d<\x92\u007fGu\xf5O\xa1\xfah\xbc\x94\x8fw!X{\xf4\xea\xa6\xd7N\xfd`\x98\x8e\x81c\xb0\xab\x05\x990\x9eFY#\xf0\xa8A\x1af\xeev\xfc\xc5nK7\x93\u007fu\x04k\xa3\x1bW\xd3\xe6\xed\xaf\xfau\x91,1\xd3\xfb\x8bP\xfa\xda1\x9f\xf6]\xb5f\xb6\xc1\x10Q\xe5ʩN\xe6m\xceh\xd4w\xa5k\x06\x13\x0e9\x15\x98\xa7kS\\\xe7,\xd8\xcd\xfcy\x0ehLBI\x8b.\x9f\x92\x82VLn\xf4\xb7:\xbd\xd86j\f\xf7\xfdX\xd6\xec\x94u\xb3ش!\xc2b\x9cP\x1btv\xe7=\x88)\xf8\xadK\xb7\f\xa7K(\xb75(S\x90\xd2\xf6\uf684g\x1f+\x90\v\x96@0\x11F\xf3\xb8B\n-\x12\x11|\x9f~;\xb1\xc2\x01A\xaf\xbbuﾏ\xa4\xa5\xef\xdfݜ\x90\xbb\x8b\x1b\xbc\xd2\xfa\xf6\xe2\xee\xa6Ov-!\aw\x177\a/\x84\xcc\x18WϨ\xad\x1a\x05\xbd\xe9\xb7.Ĥy\x99\v\xffW|h\xc6H\x18\xe5\xb4\x18\xdd\xc32@q\x8c\xc5M\x04f֧k\x17\x9d\xd3}\x13\x92%Д\xbd\x92\x1a9\xc7D\xea9u\x17\xcb\xe5b\x11\xe4GA3\xca\xc3\x06\x9e\x16\x82\x19{ĵtnV\xd0\x05\x00\xddz\xe7\xfcPA7T\xd0Uc\xa8\xa0\x1b*\xe8\x86\n\xba\xa1\x82n\xcf1T\xd0\r\x15t\xfb/t\xa8\xa0\x1b*\xe8\x86\n\xba-c\xa8\xa0\xdb9\x9f\xa1\x82n\xdb\x18*\xe8\x1ac\xa8\xa0k\x8f\xa1\x82.\xf0塂n\xc8\v\xdd1\x86\n\xbaל\x17:T\xd0m\x1b\xaf=kv\xa8\xa0{%^z2T\xd0\r\x15t\x8d1T\xd0\r\x15t\xd5\x18*\xe86\x8e\xa1\x82Ύ\xa1\x82n\xc3\xf8ǵ\x94\x86\n\xba\xd7e)\xbdv[`\xa8\xa0\x1b*\xe8\x82\xde\n\xa20\u007f%\u007fl\xc5\xd6\xe1\x85ȋR\x03\xf9\xe8\x01U\a*,?\x153\x84\x1bE[/\xd9$=\x11|\xcaf\xa5\xc42\xa9S{7\xfb(\xb1\v\x1bU\x18\x1aU\xb3;}\xee4\xaf\x8c\xe5,\xa4\x88Ό\xba*\xed&Zɉ\x92\xaf\xfd\xa4k/\xd9ZP\xadA\xf23\xf2\x1fG\u007f\xfb\xfd/\xa3㯎\x8e~|3\xfa\xb7\x9f~\u007f\xf4\xb71\xfe\xc7?\x1d\u007fu\xfc\x8b\xff\xe3\xf7\xc7\xc7GG?~\xfb\xfe\x9b\xbb\x9b˟\xd8\xf1/?\xf22\xbf\xb7\u007f\xfdr\xf4#\\\xfe\xb4'\x90\xe3㯾\f\x9c\xe8\x93J\xac\xf6\x01\xfc\x0ei\xa5\x8e\xe6!k\xce\xe9\xa3ᢡ۟\x8b\x92k\x9b\x16jOuE\xfc6\xf2\xf9\x12\x17\xfe?\xd7I$\xf1\"\xd8ŀ\x87\x03\xb9s\f\a\x92\x1c~tԲz$\xadb\xf3\x84G\xd2\v\xda\xd03y5%\xd5\x1c\x99\"\"g\xdaX\xe9S!\x9b\x95\xae\xa1ɥL\xb7LQǖ0{\x9bbQr\xf4u\xf3\x8d:\"\xa1\xe7 \x1f\x98B'\x17\xe5\xb5O\x01\x19\xc6(\x85)\xe3\xc1i\x19\xa8j\x06{\x9c_#\xab\x8axIARJ\xa6\x97\x17\x82kx\f\xb0\xc9\xdbD\u007f\xeb\xc0\x10Q\xd8lW\x9f\xe3dS\xc4C\x98mɱ\xaa+xC\n\x91\xb1dy\xea\x17\x84\x98\x87G}\x1a\xf0\xed\xfd\xbe\xa8\xa9\xba\xaf\xf7\x1fF\xc6d\xa8\xb7y\xed\xfbϭ,\xa2d\xbe\x91l\xc12\x98\xc1\xa5Jh\x864\xd9\xc7T<\xdf\x003\xf0d\x19\x14H\x91)\xf20\asr\t5kD\x87EB9\x99\xd1\xe0T\xa1\xdc\xecP\xe1'f\xc8\xccp\x01\xadHA%p\xed\xc1\x87\xb2D,ʞ\b\x91\xb9\x9c\xf8lY\xcf\xdd\x15\xa0p\xf13\x87\x87\x9fͷ\x83\xdd\xf3\x19\x9dU\x851\n\xf4\x9a\xb7&vڛ\xb6ɦ[\x97@h\xf6@\x97\xa1\xd3}\x98\xc3\xea\xfc\x98:#o\x8f\xf1lRE\xaa/\x86r\xda?\x1cc\xdc\xf0\xe2\xfc\xe6\xe7ۿ\xde\xfe|\xfe\xee\xfd\xd5u\f[4;\x05A\x97\xc2%\xb4\xa0\x13\x96\xb1p%l-\x9b\xa9\t\n\xc5P\x9a\x9e\xa6R\x84&\xc6\"\x96e\xc99\xe3\xb3F}q\x9f\\\xe5f\xdb\v$\xb3i{\xb23Iyx\xd6\xe2d\xb9B\f\xb2\xe4\x9a\xe5/V\x98CӾE9\xe7i\ni\v\x15\xc1\xf0\x9e&\xfb\xf2\xc2OaYw܈\x80I\xc8͇۫\xff\xbbB\x89\xcb\">Y\xec\x85\xeb\x18\b1\a\xa6\xe7\xae~\xb4\x15\x86þv\x8e_S}J%\xcf\xfb\xc4\xd3?\x96\xbc\xddu\xab\x88\x95R\xb9HaLn\xacH\x06Ն\x15\xdf\n\x82J \x06 \u05ccfْ\x18\xebmA3\xb0\t\xfcX;\x17\xac`ugSMi\xa6\x02\xd9s\xac\\5\x8a\xcb{c\xa2\xf6ع\n\x06I\x81\v\xed\xec\xe5\b\xba\x17S\x84E\xac\xcd\xdcHZkɯ\b\xe5\xb0\x16\xabLyL\xdfT\xb3ƈH \xccR\x81\xea\x16\xab\x95\x15\x1d\x91\x03\"\x81\xa6X\xdb[P=\xb7Y\x159U\xf7\x90\xda\x1f\xa2\xb4b\xe7e\xb0\xb3\xad\x16}\xb7,\x80L\x81\xea284\x83ڰ\xcdQ\x01N'Y\xa8\x03#\xba}\x02M?\xf0l\xf9Q\b\xfduU\x8aڃl\u007fp6M;ra\x14\xdcPƀs\x1b\xe1\xc6!\x1bhT\xcazj\vuƨ\x97d\x02\xb2\xe4\xe7\xea\x1b)\xca@\x91\xbe\xa6Z\u007fs\xf5\x0eyai\xed\x0f\xe0Z.\xb1\r@8#趯\xc8\xf7\xe6ܹ\x93\x16\xaa\xb2x\x160%%W\xa0\xc7\xe4=]\x12\x9a)\xe1ͺ`k\xf6\x06\xb3\xfc\x9a\xfe\x971\xba\xe7,02\x11:\x94\xaf\xac\x80C\x16\xb0\xfe\x95PߞA\xa6\r\xc8V\xbe83\xbf\x15\xa8\xa1@\xe9=(RHH \x05\x9e\x04\xd2j#\xb6\xfa\xa7\u007f~\x91\xb4-\xa4\xf2k\xc1\r\x03\xe9A\xe7W<e\t\xb5R\x8e\xea6\x9d\x86**\xa5\xd2\xde&\xa7X\x11\x8d\xec\xa3T \xb1\x85\x97\x96%\xc4l\xf5\xb7\xe5\x042\xd0\xd6e\x81ݻ\xa8\xb6\xad\aXN\x83ow\xa7\xba\x12mZ\x10ઔ\xe0\x9c\u009a\xa4\x02b\xf2\xcbܢ\xbf\xbfzGސ#\xb3\xeac$\xf5)e\x19\x96\xfci\x1a|Q\xfa\x8a\xc7c꧇\xa8\xc4\x13O\x82\xbb8!\x13>!\\\x10U&s\x8fK&x\xe5\x0er\xb9\xb5\x11\x91\xb55泉\x9d\x84\xba\xdbk\xe6\xf3\x8f\xc3Nz\x89\xbe\xef\x15Ȟ\x92\xef\xfbg\x97|\xf1n%\xc3O\xda;\x85l\x80\xe4\xa0iJ5\r\xbb\x0e\x1f!\xf2F\xbf\x98\x81\x90W\x80\xfe\xca䢂\xef\x18/\x1fmrk_\xe7\xea\xed%\x02#.xb\xed\x84P\x81S\x14\x19\xb3-\xf2V:A[F^\x85\x13{\t\b/Ӑ\x91\xd3,\x13F\xa8\x87k\xfe\x94\xa7\"_[\xb61\xe6\xa0\xd5G|\x8c\x1c?\x14\xfep\xacj\xa0\xbd\x8eU\xbc\xfb:\x83\x05\x04\xb7?\\\xed\x8bn`\x18\xa3\xce\xd3\t\x02\x8d\xf0\nft\x02\x99U\xbe\xec)Q\xeb\xa7$\xd2[\x18\xe5j\x94\"\xeb[\xa2\xf8Qd\x98'J+\xe4\x18\xa0\xbf\x01\xdc\xe0\xab\xfdp\x83^\x9a\x16n\"\xbdɯ\r7e\xb0\xc6EVqc\x94\xb66n\f\xd0_=n\"]\xf0\n\x92D\xe4ō\x14S\x16z$ׄ\xb8\x03V炠'6&\xec\xd8\xce\tF\xcfH\vt\x84\v\xbe\x90b\xc1RH\x8d]\x8a2\xccg\xaa\xfc\u007f\xd1v8r\xe3\x93\x15\xfd\xc0/^,@ʰ\xfb\x06Hݡ\u0603y1i%\x12\x9aac\xf2\x18J \xab\u0530\n\x8e0\xef\xfd\x888h\x98<\x82P\\\x9e\x97\xed\u058c\xbfD\xb7\x8a\xe0\"\x85F\x1f\xcb\x12/\xa2\xb8\xb3\xd5\r\x06r\x04H_\xe8bt\r\x9f$\x94\xfa\x9c\x0f\xf3\xbd\x98p\x9ep\xcd\xff|\x01%ED\x03O\x19\x9f\xa1w?\xa6\x19\xa4\x84\x8cb\xe1\xa9cX\xf7\xd6\xf1s\x88\xa7\xceM<\x02\xac?\xa4~\xbbP7f\x82\xbb\xd9\v\x1e\x9c\x02Hl\xf7fT=\xa7\xc8\xe3\f\xeb>\xf8ΓWp\x0f\xe6\xcf-\x99\x0f\xec\x1a*_`L\fɌ{\xc6SW\xd1\xd3B\xb9s/Ŝ2k=\x8d\xc9'l\xdb\xef\xd9\x18\x95pF\xfe\xc6I\x85\xf2\bУ\x1dG8\xe6\\\xb8#\xb5v\x84?Z\xf3,.|b'\xbb5<\x17\x01\xd1/}u\xaa\xdfs<mቫv\x9a\\t@\xf6\xbbx\xf0r\xe7\"\xb6\x90.X%\x8dTq\x1e\x18OŃz\x1a?\xc5\x0f\x16\x987P\x13Ú4\xe3\xb3`\x8d\xa4\xf6U\xd0,k\xe5\x81\xf5wV\xf8\xb3\xeb/(\xea0\xcd\x03\xa1z\xaf\xb5%\xdcv\xa4\xaa\xa7}\xbe\xc1u\xd0\xe5\f\b\r\x86\xae\xb9\x0e>\x9b3`\x96+z!\xcd75\xa3\xd9m\x11\xda͛\xac\xd2\xe27\xefo\xcf\xdb\x00\xe3Z7?\xe0\xcd^\x06\xd7\x06\"\xa1iΔ\xc28\x05L\xe6B\xdcG\x80<\xf2)\xd43\xa6\xe7\xe5d\x9c\x88\xbc\x91M=Rl\xa6Nݙ\x1c\x19\xbc\x1cG|\x83\xf1\x8c\xf1F&\x05\xde`\xe5|\xe0f!\x11 \x93\n\x9bHp\xeer\x10\x97\x04\xb9\x8e\xee\xeb\xb8\"~\xec\x85\xf7\xa2J\xcb:\xe9]G\xb5<\xdcA~\x91\xf8p\xfd\xd2\x1b5\xf1\x96\x10\xeb݈\xd1C\xcd\xfeY\xe3\xf3e\xbdZ>(\xf4\x04\x18\xc6\xe0\x90\x03e8\x99\x13<q&\xc4zxi-`\x14\xa7\u05ec\x85\x98\x10h;p\x14u\xbc\xd7CM\xad\xe0Q\x04\xcc\xfd\xc2M\x11\x80\xb7KC\x12w\r\xc0\xf3HD\xf2\x1cR\x91\xbc\xb8\xdb*\xa6\xdc\xc96\x19\xeau\x8b\xcam\x03FÄ\xd3s\x1az\xfd\xe7\x8dHm#\xb5\xaaA\x13^ى\xcd\xdf\xd8\u007f[\x15+ĉW\x17\xcaqak\xe5\x9a\xdd\xd5\xdcU\x12!\xc4bl\x9e\xcc\xfb\xe1\xf2\"3\x92\xbb5[\x9bg\x12v\xe3Z\xe3*\x97\x93\n\r\xf5\xbd1\xae\xab\\\x88\xc2\xfb_\xa5҄V\xa5:\xbe\xad\xd4M\xf5!\xeb\xd4\t\x9a\xa5\xbbp\v;\x1ak\xe1݆$e\xd3)\xf8R\xa3\t\x90\x82J\x9a\x83\x0eK\avy?\x13\x981[\xff!\xa6\x84\x1a4\x1c\x1e\xaa\xba\xbfQ\b\x06\xb0\x9a\x84i\x92\xb3\xd9\xdc\x1edBI&\xf8\x8c\xf8ěLД\x18\x1e\x1a\x00UH\xf2@eN(Ih2\x87\x13[n\x95\x96\x12\xdb\xebk\xa0\xe9r\xa4tX\xdcӨ\xce\xce\x1b\x84\xf9\xa5\xc9z\xa3\x87\xc0\x9dB'\xfe\x044\xf5\t\xa9>\xaf\xd4km\xcd\x03\x1b\x00\xd7C\x9bft\xf6Z\x1a\x12\x0e\xd7\x06u\x8e\xe1\xda 7\x86k\x83\xdac\xb86h\xb86ȏ\xe1ڠ\xe1ڠ\xee1\\\x1b\x84c\xb86h\xb86h\xb86h\xb86\b\xc7pm\xd0>c\xb86\xa89\x86k\x83\x9ac\xb86h\x9f1\\\x1b\xf4\x0f\xdc\f{\xb86\xe8u5\xc3\x1e\xae\r\xda6^{\xab\xf0\xe1ڠW\xe2\xa5'õAõA\x8d1\\\x1b4\\\x1bT\x8d\xe1ڠ\x8dc\xb86Ȏ\xe1ڠ\r\xe3\x1f\xd7R\x1a\xae\rz]\x96\xd2k\xb7\x05\x86k\x83\x86k\x83\x82\xde\nL\xa3LY@\x83\xf1}\xfa\xe6\x057\x8a\xf7=7\b%\x93r:\x05\x89\xba!\xcel-\x8f$\x00\xac\xefn\xec\x13\x1b}\xbe\x87\x02}\x82\x8d\xfal=M\x88\xf6\xdf9%\xdf8\xe4\x81.\x15\x91\xa0\xc2j\xca\x18'\x97\x1f\xbe\xae\r\xaa\xf0\x86\u007f1\x1d\x8fp%\x1fx\x12\x9b:[o}Ge]\bFm\x02Y\x92\tes\x9b,\x8a\x939\xe5\x1c2g\u007f\x04%\xf7̩\"\x13\x00ND\x01\xdcf\x0eR\xa2\x18\x9fe@\xa8\xd64\x99\x8f\xcd\xecCTd\xb7\xed\xae\x13{=K\xa5%\xd0\xdcn\xbf\x84<\xac\a\xbe\x99\x1e\xa1\x89\x14J\x91\xbc\xcc4+\xaa\t\x12\x05X\xb2\xa3B\xb3\x86\xfd\xa6b\x82\x14\xd84\x1eY\xc2I\xbd\x02\x8b\x94\x90i6{\xf1\xa2\x85v\x82W\x80\xe4\x85^VI\xc5@\xa6L\x06\x15\x92&\x19CC\x00\xd7k\xfb,\xe0\x1cO\xd0\x12\xd4\xd8Q\x1d1\x1a\"K,Jy\x8a:Q\xa1\x15&\xc96&\xe9>\x9a2\xe5\xf4g\x15\x92@G\xb5\x17},\x87\x1a\xa3H\xba)~6|\xc6\xee\xe5\xc6\x14\x1b\x8d\xfa\xeb\f\xea\x10\r\xc93;l\xce\xea\x99\xc9I\xf3>\x18_\xe6\x11\xe4e\xc0t\xb0\x9ai\xba\xf5#\xe9sX\x98\xb3\x0f\t\xb0E\xc8٧\x1b8߳2>\r2g\x1cӖ߃Rt\x067Aa\xabM\x06\x1dF\xaej\x12\tR\xe9\xb1\xe2W\x8b\x86fU\xa7M\x1e\xaa\xe6\x94\x03\x80\xe6vuU:\xfe\x83dZ\x03\x92,vU\xc68}\x90N\xbf6\xb1fw\xdb\xf7\xfes\xf63!\x02P\xa1\x9e\xc3S\x9b\x9e?\x012\x91\f\xa6d\xca8\xcd\\\x0e\xe1\tv]\f\xa1-\xeb\fQ\xca\x18\xfb\x82\xfb\x145\x8f\x951\xf9!\xb8\xac^˒'\x98\xc0\xe8\x92ѱZ\x9dM\xc9\f\xf3\x1a\xa5M\xa9\xff\xe77\xff\xf6\xa7\x00\xa0\x93\xa5\xd1I1H\xae\x85\xa6Y\xb5m\x19\xf0\x99\xa1(+ h\x16\u2e6bk\x8f\xab\xdd\xc7{\b-\x82\xdf\xfe\xe1~\x12\xa5\xaakANSX\x9c6\xe8q\x94\x89Y\xd7\r\x8f\xfb\xab\xc9\x11\x86u\xc7\x11\xc6\v\x83\"\x0f\xb1o\xe3J\xe6\xe2\xc1\xf6+\xefu\xde\xea\x94\xf8B\x14ef\x83\x19_W\x9d\x1c\xc2\xda\xe7\xacU\xc3vr\xaf0\xd3\xdcOkE\u07b8d]\xbf\x8c\xa0\xb5c\x99\x9cs2W\xdd[K\tc\xf25Ͳ\tM\xee\xef\xc4wb\xa6>\xf0K)\x83Z\xafz\x9c\xd9j \xaa4I\xe6%\xbf\xb7ר\xf9\xa9g\"\xc4'#J]\x94\xdaW\x1850Z\xad\xdd\xf6\xb7\t9\x1aV\x1dr\xaaKcf\xf0\x88\xa7\ue059\xa3\xcc\t\x98Շ\bs\xc3\x1721\xab欚\a\xf9\x0fo\xfe\xf9_-\x03\tY\xbd$\xff\xfa\x06\x8b\vԉ\x158(\xbd\x8d\u0098\xd3,\x03\x19\xcb\x1a\f\x89w\xb1\x82g\xe5\x04:\xf6\xd0?\x83\xe9zw\xf7W\xb4[\x99V\x90MOli\xaa\xef\xb9\x1f\x00\xf2\x10U\xabC'\v\x8d\xfe\xfe\xd2\xc6\xe1Bde\x0e\xef`\xc1\xe2\xaf\x13n\xc1\xf0\xd50\x19S\x9a\x88\x10\x93f\x92\x89䞤\x0eL#\xc7p\xf5.\xa3\xfd1\x12\x9cG\xb9q]\x8d{!)\xc9iQ\x84:\x87\xb1XP҇\xd62\x91[`3\x9d(gnl\x84\xc3~<L\x19\xf6o6\xf0S\x83\xf1\x9b^\xd0\xe0\xde\xf7\xc4\xd7\xe3\xac5A\xae:\xad\xdb\xef\x04\xc3\xf5\xfa\x90\xd9-䢡\xce\xe7\xe8@@L~i\v\xb3\xbc\xf2\xa1\xe7T;;!*\x82\x84TW\x80TL\x19\xc5\xe2\x13R\xf4EFY\xee\\[\xc1\x10\xc3CN\xd1W\u007f\x84\xfb\xeaG\r\x9a\fz-\x10\xb9=\n\xdfC\xb2--\x03«[by\xf3\x8dH\x1d\x18d\xa9\xf6\x92\x1dc\f\x06n\xfe\x86\xe2\xbe>J@?\xe6\xfc\xa9\xc6M\x9b7\x9b_\xa2\x98\xb3\x85\xf8\x99X2N\xbb7GF^\xec\x16ЯAHӽ\xe1\b\xa8a\xee8\xaf\xc2\xd8\xe6x\x04\x037\x14\xe3\xa6F\x0e\xcf\x0e_\x8c/[$KQ\xd0Y\xc4e\xab+\xb8^\x05FR\xb0\x06FDI\x831G\x11\x9e\xf2\xddN\x11*\xa4U\x17\xb0\b\x90\xb6\x10\xab\x96\xa7\xded\xb1-&\x1e\x82s\xbe\t\xa1R\x94<\xb5>\xf5:\xbc\xf2~\x05\x11ׂ\x87O\x97\xa9\xaa\x8b\xa7Q|\x19dX\xfa\x8b\r\x02\x18'o\xc7o\xdf\xfcz\xc47\xaeaE|G\xb5Xj\xf0\xa5\x17[\xbd\xbfr\xab\x17\x06\xde;\xb7c}G\x16\x8b\xbb\xd9\xc6\xceg\xf4 \x99\x86\xc6E\xe2Gh\x1a\x19\v\xb7\xd1X\xe88<\xbb\xa0\xe7\x05|\xf1W\x90\x10\xa2\xcaɓ\xf3{˨\x83\xb1\x80L\xa6\xcb#\xadb!v\x88\x8a&\xaa\x0f\xc2;\\\x1eٙ\x1c*\xec<\x10\xbc\xd5\xd1\xc7\xc1m\xd3\xe5c\x11ܻ\xbc\xb5U\x97\x8f\x05E\xbfw\xd1\u07b3`D8a\xbcy\xcfb!v\xecٟaN\x17\x11\xf2L\xb1\x9ceTfK\xb3ٷ\x16\x83dRj\x02|\xc1\xa4\xe0y\xccU\xab\v*\x19\x9dd@$`3\x9f\x04\x14\xf9\xf2\xe8\xd3\xf9G\xcc,:6\x923\x18&\xf8])\x15\xe3\xb35\xeaoL\xb7\x1fo98X#`\x8f\x17CYᒘ\xa7\x15^\x8dƐ\x97\xba\xb4\xf7\x93>&Y\xa9\xd8\xe2\xa5\xe4E\x9c\x95Vi\xbb\xbf\x01#\xcd5Xy\xc7\x02\xf8\xc3J\x1b\x99\x9a\xe0ֺ\xb5\x04\x86\x83Q)\xab\x1b\x8au\xa6l\x04q\b\u007f\u007fb\xb3\x87\xacs&\xbb\xb6U6\xfd<\xbc\xef\xf8Zj\r6\r|Y\xb7r\x18\xf5\x06P` \xed\x85P\x9d\xcb\x11\xdcg\xcam\xa5Ծ\xe7zx[\u007f]N\x1f1\x01\x8f\xe2\x81\xdckebj\x93\">A\x06Rx\xa1\xf1@\x99\xae*\x13\x18g\xfa\"\xec\xc2e4Tl\xab\xba}\xb6;`\xa3\xf7܉\xbd\x1e۵M\xdb\xc9i\v\xf9\xec\xf8\xfa\xe6\xefn|\x91\xf1$+S\xb8\xc8J\xa5A~\x04%J\xd9\xe1\xe1_\x89\x8ev\xbe\xd3(:xp\xa1\x94\xc4>2R\x89(:\x0e\xbd\xac_\xadt\n7\xa1\xd4\x17\x16b\xbd\x8a5\x9c\xab\xee\vJ\v\t\x9d\x89P\xbc̲\x95\xf4wY\xae\x91\x8ay\xcah\b\x9d\x99\xc1\x9b5u?5c\xa2\xa9\x82\ue266\xc6㶙\x9d\xcaX\x82nl\xee\xff\xc1\xfe\x97\x99\xad\xfb\xc4ں\xec\xce\xd9<\x1bL^\xc4\xe8\xe2\t\xb6\x15\xe75|[/g?\xbb\xba\xe8\rn\xb4-Gd\x0f4\xadӚ\xff|\x10)\xd5O\xaf\xa0\xc8S\xc8n\f\xad\x13G\x13G5\xa5\xb9\xe7&4\xb9/\x8b׀0\xbca\xe8\x162\x94\xe3[\x91\xf5]\xf3I\x8b\xa8\x1c4]\xbc\x1d\xb7\xff\xc5ب,Ә\x85ڡ:=\xd8L:\x83'\xa3B0\x9e\xb2\x05KK\x9a\xb5\xa8\xac\x81\xa5\x1a\x99X\xa2\xc0\xb2u\xe3\x1c\x9b\x84\xb9\xb7[8%>\x1d*\xe8\fn\U000ce8ab\xc4(\xc3.!\xb2\x8b\x89\xb6\x1dp+/X̹\xb8\xa3\xbb\xe0Iy\xdc9\xd6l4\xf9\r\xa5\x8bw\xae\x01\x8c\u007f\n\xd7{~\xfd\xae[\x01\xd9\xe2\xbcnM\xf2|\xcbDܙ\xa8\xb6wN+\xb7\xe8&\xa9\x89\x99\xf2\xea\x84Pr\x0fK\x9b@I\xb9\xeb\xce\xe9A\xe0\xfd0N\xc0߃MU\xb0\xefu/|\xb7\xcb\xfa\x1e\xb6x\x83Z\xcb5\xdf\xf3\x01`\\\xb7\xf9\xa1\n\xe4UKuWnm\x93\xc7[\xa2u{H\u007f\x8f\x91=\xa7]!P\x82!'\xbb\xfdf\xee\xc6Z\xc3\xebv\x04WsV`\x11ΖY\xe3u\xc3S\x8f\xed\xea2\x16\v\xdcR\xd4\x15?!\xd7B\x9b\xff\xb9|dJ\xab\x1d=\xa6\xdf\tP\xd7B㳽Pb'\xb5'B\xec\xc3H\xa0\xdc\xf26,%A\xf8\xd5\xf2\xae\xa6\xee\xc2\n\xbb\xbe-\x8b`\x8a\\q\xc3d\xdcʫf\xd8\xca\x01\xf7\xf5B\\\xf0\x11r$\x0f}\v\xd0jӘ\xf2\xa8\x14\xb2\x85\xaf\r\x1f\xda\x02s\x02\xc4}\x1e}\xb8\xf6\x1dL\xcf-2\x9a@\xea\xdb\xe8R\x83\v\xaaa\xc6\x12\x92\x83\xdcz\xbdva\xf8\xd4\xe6\xad\xdb\x19\x06\xdbK\xd9\xed\xab\x9a\xdeC\xf7{\xa3\xed\xdb\x1b\xad\xb8:~\x8f\x02\xaes\xf54\xf5\x1d9ov\xf0\xa7\x1d\xf8Y\x97\x19\xf6\xa3N\xd0\xd2\xc2P\xf6\xff\x18v\x8a\x84\U000bf920L\xaa19w\x95\x04\x9d\xdfl>\xef4\x8f&h\x03\x95)bp\xbe\xa0\x19ضm\x94\x13\xb0E\xb1\x9d \xc5tM\xa2\x19C[(\xcbū\x90\xc8\xc1=,\x0fNZ'oS\x02\xdb\xc1\x15?\xa8\xb2\xec\xdb\xe7\xc0\xcb\x19\xdb\x1e\xf8\x00\xff\xed`\xbc&\x04;\xc1n\x15\x8c[(b\xe3?U\x9a\xee{\x9bX\xb3\xba\xcf\xfb\xd1\xc2\x16:X\xeb_\xd3\xfcZ\x8b\x10\x9ajiK\x85_\xff\x1c\x953\xd0]ʾ\xd3U1\xcc>&\xe7|\xb9\x06\xb5\xbb̺2\x91*\x8a*Z\x1dօt\x89\xdcM@.mF\xd1\xdc\xc2_ݓ\x8dHGA'\x17p-R\xb8\x11r=à\x1diX}\xba\xc3*l,]d)\xe6L\xe3\xa3k\vE=\xd4\xe9\xa0Oc¹\xef\xde|ھ\x8a\x8f\xd5cۧO\xb1\x9f\xafۍ\x9bO\x1bV\xa08-\xd4\\hr\xb4`\xd4U\x9d\x882uM\xe7\xe5Z\x8c\"rm*\x99CZf\xd0u/\xc9Z\x03\x1f\xff\xa0\xd7\xc2J\xce\xfe^\xb6\xafh\xf1\x9e\x1b\xf7\xf4:a\xd7x\xa8\xcc҆OѰ\x93?\xe3\xde\xf9\xef8{\xcc\xc15\x14\xdb\xe5\x11\xa8\x00Z\xda\x16Jc\x1d\t\u05cd\x96\x15\xde|K\\\xfb`\xf78S\xd5l\xbb\xc9{\xed\xd0w\x89\xbb\x91\x83\xbe\x12\x89\xed< 6C\xba\xf9v\x17\x1d\xdd\xda<\xea\x84\x16\xba\x94\xfeB\xa3R\xe2E\x03uCd\xea1\xe3\x90\xd0\x00\xbaI\xf5v\xbe0&\xf8\x1d\xcbAi\x9a\x17[w\xfeb\xfdy\x83\\!S;){\x9d@mF\xd7\xfd\xfc\u05edHZ\xdf\x1a\x91\x8e\x1b\x90-\x10ԅ\f`H\t,\x80\x13Wp\x81\xb1^k\xa2\xaf\x81\xb4\xb7\xcb\xca\x05z\xb8=\x14\xcc\xec\x9c\ni\xfb\xfcW\xd3^=j\xbe\xac6\xa5\x1aF\x1d\x05\x87{\x9c\xa9\x0e\x89\x80\xc9\xd9\xdbY\x05f\xaf;%!\xc1,#\xb3\x95Yf\xdf\xf5\xf9\xe3\xf6\x02\x11\xf2\x00\x12\xc8\f\xb8Aj\x87?\xcc)\x8d\xb6\xbd\xbbA\xa5;\x89\x95S\xe3\xcev\xc27\xb6\xba\x9d\x1a\xca؊\xe3oR\xb0\xcc\x03t\xb6\xe1Lt\x15\x14\xbb\\\xfd\x8f@\xd5z\xf6Kk\xf9_7\x9ftv\x80]\xb95S\xa9\xbdb\xc3^K\xc4$t\x10\xb7\x9b\x8c\xc0\xaf\xeeyn\t)\xe6Tmgs7扪\xe9~\xe3\xb8U\x1c\xeec\xe7\\\x80\x97\xf9*\xe0\x11\xb9\x86\x87\xb5߾F\x82F\xeb\xad됌\xc8\x15\xbf\x91b&\xd7\xfb_\x8d\xfc\x81Y\xa3\x82\x11\xb9\xa1R3\x9ae˯\xbb\xba]\xfb\xaf\xee\x8b'\xd5:6\xdb\xe5B\xeb\xd1=\x19\x83a\x04\x1d\x04gk\x14_\xe1\x91^T\xbbu\xb9\xfbp\u007fZyx\xc5;i\x8ey\r\xcf\x1f\xc9#\xb6\xee\x97FGFbf\xbbz\x11\xde\vy\x19\x1f\xa8\xe4\x8c϶/\xf7\a\xf7P\a7s\xef?\x1f?\xf3\x13ls\xb4\r\x8e\xf0P\x8e\xd6!\xbbW~Z\x80T֣\xf1\xb6\xfe\v\xb1e\xc31\xee\x1f\x88\xa5洁{7\x15\xf7K\xad\x10\u0602c\xe7\xfe\xb7h\xbfg<=\xf3I-EVJ\x9a\xb9?\x13\xc1\xad\xe9\xa2\xceȏ?}A\x1c\x06>\xf9y\x98\x1f\xff_\x00\x00\x00\xff\xff\xdf\xfeQ\xd8\f\xbb\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko#\xb9\xd1w\xff\x8a\x82\xbf\xc3|\x01,y\a{\tt\x9b\xf1x\xb0\xce:\x1ec\xec\xf5%ȁ\xea.I\x8c\xd9d\x87d\xcbV\x16\xfb\xdf\x03\x16\xd9O\xf5\x83\xed\xd8\xc1fa\x9el\x8a,\x16\xeb\xcdb5O\x16\x8b\xc5\t\xcb\xf9\x03jÕ\\\x01\xcb9>[\x94\xee?\xb3|\xfc\xb3Yru\xbe\xff\xb8F\xcb>\x9e<r\x99\xae\xe0\xa20Ve\xdfѨB'\xf8\x057\\r˕<\xc9в\x94Y\xb6:\x01`R*\xcb\\\xb7q\xff\x02$JZ\xad\x84@\xbdآ\\>\x16k\\\x17\\\xa4\xa8i\x85r\xfd\xfd\x0f\xcb\x1f\x97?\x9c\x00$\x1ai\xfa=\xcf\xd0X\x96\xe5+\x90\x85\x10'\x00\x92e\xb8\x02\x93\xec0-\x04\x9a\xe5\x1e\x05j\xb5\xe4\xea\xc4\xe4\x98\xd0\xe2iJ\x181q\xab\xb9\xb4\xa8/\x94(2\x8f\xc9\x02\xfer\xf7\xed\xe6\x96\xd9\xdd\n\x96\xc62[\x98e\xbec\x06\t\xcb\x14M\xa2yn\t\x97\xbb\xb0\x04\xf8a`\x8ad\a\xcc\xc0\r>\x9d_J\xb6\x16\x98\xd2$\x8f\xd0\x1d\r\xa2\x0e{\xc8\x1d\x86Vs\xb9=Z2\xc7dY\"\u007f\xbc\xe6\x85V\x12\xf09\xd7h\x1cA %\xf2\xca-<\xedP\x82U\xa0\v\tv\x87\xb0f\xc9c\x917\xd7o\u009c\xc4\xc0b\x96\vfqi\xad8\xc6\xe2'\xf5\x04B\xc9mc%\x03f\xa7\n\x91\xc2\x1aA\xa3e\\b\n\x1b\xa5\x1b\x18|\xa6\x81p\u007f\u007f=\x8d\x83'\xbc`\xc6~\xae7\xd2\xc2\xe1\x9a\x19\v\x96g\b,\xa0\x00O\xcc\xd0\xfe7J\x83\xddq\x03-:z$hZ\x03\xa6\xc7\"e\x16\xbb8\x94\xe2\xba<\x12\xb5\x06\xb8O[<\x06\xb3ժ\xc8WP\v\x9e\x1f\x1d$\xddkI\x8b\x1d\x82\x1b\xfbs\xab\xfb\x9a\x1bK?\xe5\xa2\xd0L4\xe4\x99z\r\x97\xdbB0\xbdjn\xd1\t\x05\xea=\xfe\"\x1f\xa5z\x92_9\x8aԬ`\xc3\x04I\xafI\x94\xc3\xf1\xc6a\x92\xb3\x84\x84\xd3\x14k\x1d\x14լ\xe0\xd7\xdfN\x00\xf6L\xf0\x94\xf6\xeb\xd1U9\xcaO\xb7W\x0f?:\xd42\xe6;\x874\x81\x1b`\xf0@\xfb\x86\x120\xd8\x1d\xb3\xa0\x91Г֍\xc85.J\xc4S\bBB\xbbE\xcdUʓRVhjC\xb0\n\xb9\fcs\xadrԖ\x97Tu\xada\xa8\xaa\xbe\x0e\xa6\x1f\xdcV\xfc\x18\xaf;hH\x88\x83\x81\xc1\x94\b\x9a1P\x1b/B\x15\xdeD\x92\x06XpC\x98\x04\xb5\xfe\a&v\tw\x8e\xf4\xbaR\x83D\xc9=j\xb7\xefDm%\xffW\x05\xd98-uK:\xf5\nL.\x1b\x19#ɄcB\x81g\xc0d\n\x19;\x80F\xb7\x06\x14\xb2\x01\x8d\x86\x98%\xfcUi\x04.7j\x05;ks\xb3:?\xdfr[\x9a\xe6DeY!\xb9=\x9c\x93\x81\xe5\xeb\xc2*m\xceSܣ87|\xbb`:\xd9q\x8b\x89-4\x9e\xb3\x9c/\bqI\x96y\x99\xa5\xffW\x89Ǉ\x06\xa6\x1d\xd5\xf5\x8d\xe4z\x90\xeeN\xbc\xbdx\xf8i\x1e\xff\x9a\xbc<X\x93\xef\x97w\xf7M\xd1\xe1\xa6Ms\xa2vC\x9aj\xc2;Bq\xb9\xc1\xa0\xfb\x1b\xad2\x82\x882\xcd\x15\x97\x96\xfeI\x04G\xd9&\xba)\xd6\x19\xb7\x8e\xd3\xff,\xd0Xǟ%\\\x90\x83r2W\xe4N\xab\xd3%\\I\xb8`\x19\x8a\vf\xf0\xcd\xc9\xee(l\x16\x8e\xa4ӄo\xfa\xd5\xf6@O\xad\xaa\xbb\xf4\u007f\xbd\x1c*u\xf8.Ǥ\xa5\x1an\x16\xdf\xf0\x84\x14\x80lk\xa5\xe2-\xfb:\xac\x97\xb4v\x18\xda\xee\x1d\xb5#\xb1\xbe\xae\x03\x11\x82\xf1Xv\xfa{iG?\x04_7\x8a\xda}\x18\xe4PsTI\xab\xb8ƛ\n\xacL\x96\n\x96\nT?v\xb9V{\x9e\x06{С\xde\x18\x05=J\x1bV\b\xfb\xe0\xe2\x154\xf7\xea;\x1a˓\xe3q\x1d\xe4\xbf\xf4N+9\x8b\xc6Q\xd4\xeeP;ţ\x1fȆ\xf5@\x05\xd2\b\x83)\x191\xf6\xd8p\xbe\xce\x1a\n\x01\xb9Ja\xefׁ\xf5\xa1D\xb8ˋ\x9a\x1fk\xa5\x042y\xf4;>'\xa2H1\xad\xdcU\x0f5:\xbb\xbc<\x9aBQ%\xe3\xd2I\x93\xf3\xb1\x0eIY\xff\xea\xbcK\xef&\x99Fp\xeaϥ\x87\b\xbc\x19T\xf5m\x86[\xccz1\x1c\x91;\xdf\\\xd4\xeab\xc5\x15X]\x1cKK9\x9fi\xcd\x0e\x83T\xfa^9\xf1X\"U3\x82Q\x16<AG\x9e\xca\xf4\x12\x9d\xfe\x00$\xda)\xf58M\x96\x9fܨڭ@B\x87\x18X\xe3\x8e\xed\xb9Ҧ\x1b\x89\xe03&\x85\xc5~\x1da\x16R\xbe٠v\x90\xe8\xf0`J#1L\x9e1\xb5wM\x8f\xb1\xf8h?5{\x1d\xa3\x88\x06C[ s:\x00\x13\bags\x8b\x1c\xb8L\xf9\x9e\xa7\x05\x13\xc0\xa5\xb1L&~_\xac\u00ado_0\xce\xfa#̽\x19-\xf1w|iy$%\x11\x94\x86̹\xdf\xe3\xa1fp\r\x18\xdc\xfe\x9a9{\xe6\x8d5h\x17b\x87\xc5\xe8\xfcҰ\x17g#\xc0+\xee\xf8\xa0M\xb05\n0(0\xb1J\x0f\x91e\x9a\xe9\xbe\xc5\xd9\xc2\x01z\xf6X\xc5\xda\xee\xbb-\xd7\x1b\x1c\x05\n\xce\xe4?\xedx\xb2\xf3\xf1\x95\x93)\x82\x04\xa9BC\xb6\x80\xe5\xb98\fo\x16\xa6%!,4n\x0e\xea6i\x18\xba0\xfbMDݢ\xeci\xdd&,k\x9bΕ\x88\xbc\x93\xb9t\x1a/\x12諣ɯ-Ў\xc0ܝ\xad\xae6\x80Yn\x0fg\xc0m\xd9;\rӅA5\x0e\u007f\bF\xbdD\x1f\xae\xbas_Y\x1f^\x81K\x15\n\xff\xd3L\"gs\x17|\xcd\f\x06]7\xe7\x9d\x01\xdfT\fJ\xcf`ÅE\x8a{\xc6Ql\xb8\xbeIN\xbd\x16Y⼦k\x19\xb3\xc9\xee\xb2:BN\x8e\xefP\xa8;\xdd\xc7\xca\xe5I\xa2\xed\xe4'!\x03e\x18\xb8\xc6\xcc\xe7-\xeeI\a\xea\x1e\x8a\xd4>\xdd|\xc1t\x9cP\x10+\x91G\xdb\xf9\xd4A\xb9\xb9|8\x06\xc4o&\x04T\xd5\t\xcb\xe7\xa3\u0380\xc1#\x1e|\x14\xc4$8F1K\xf9\u0601\x83\xc41\x91(1F&\xe2\x11\x0f\x04(\xe4\xba\"\xe6ǋ\x86o\x8fx\x88\x1b\xd8!\xa5\xc3,d\x02<M]\a\x11\x84R&s\xc8\b\x94\xb9$\r\x01\xabb6\ts\xccM\xd9JN\xbch\xbb\x15\x1b[i\xdcG<|0\x9eaN;v<\x8fް3\xc0`\x90\xf4\xa8\xccd>0\xc1\xd3j)\xaf\x0fWr,\xean\xb7\x1be\xaf\xe4\x19\\>s\xe3Г)|Qhn\x94\xa5\x9e7#\xacG\xffEd\xf5SI\xf5\xa47\xf3\x8e\x1e\xcd\x04i\x94\xd0\xfbv\xe5O\x98\x15\xab\xb8\x81+\xe9\xceJ\x81.\x94\xe6&\x98\xf1bI(e\x85\xa1L\xa8TrA\x8evٳV4\xcc\xc0\x1e\xa5[\xdci\xa2\xd7X6\x1a\xaa;\xd0y\xd4\xee\x9d\xef\xf1\x10|\xfa^\xb0\x04SH\v\"*\x8b\x86h\xacf\x16\xb7<\x81\f\xf5\x16!w\xbe \x96\x1b\xd1\xf6ٷ\xd92\x17\x1b\x1a\x94-\x18\xfa4\x06\xa5\x85\xd3\xeb\xa8q%\xfb#\x06\xf7\xe6\xa3\xc7\a\xc7\xec\x8d\x1c4\xc51\x11\xd4n^\xfb\xce\xf1\x12\xb3\xb8s\x1c?x\xf4\xbc\u007f\xcd\x18%J\u007fu.\x92\x84\xfd7\xc8\x19\xd7QZ\xfe\x89n\xfd\x04\xb6f\x87\xac[s!\xb7\x067\xe08\xbeg\xa2{\xdb1\xb0E\xe5,\x10\n\x1f\n\xa8\xcdQ\xe4s\x06O;e\xbcG\xdep\x1cH\x0e\xb7\x1b7p\xfa\x88\x87ӳ#\xbbtz%O}\x88\xd0\xd5\xfa\b\xb0Uġ\xa48\xc0)\xcd>\xfd\xcf©h\xe9\x8c\x1cHw\xc3\xf1\x81\xb8;ɖф\x9bZ]>\xba\x10z\x1c\xfbH\xd9̕\xb13\x10\xbaU\xc6\xfa\x8ch+\xe0\x9d\x97o\x03/W!\xcf\x06lcQ\x83\xb1J\x97W}\xceHv\xd2Ǝ\x8bf\xea\xc0\xe1\x18[e\xef<Xw\x98;\xad\xf5\xdb\xdb\xdfS\u007f\a\xe8\xfe\x9e\x82\x98P\x00C\x90s\xad\x124fJl\xa2,\xfcDb\xb3Jj2\u007fX\xa2\xbb\xb5Ia\x9dJ\xb6\x96mN(\xec\xc89\xfb8q\xf9\xdc\xc8\xcb:\xf3\xe1\xfe\x9f\x16\xd9\xf9\xd8\x01i}\x961\x19\xe5\xc0\x8e\x10\xbd\xf0sK\x15\v\xa0\xfc\x11Eo\v2\x17s\"\xd7 |\xbf\x9f` \xe3\xf2\x8a\x16\x81\x8fo\x12>TF\x17_v|\xb8(g\xd7,\xa8:\xfa/I\x87Z\xae\xe8\xbeBc\x8b\x93\xc7Y\xfdYa\xb3T\xb6\x99\xfap\x90s\x95~0\xb0\xe1\xda\xd8\x1a\xd9h\x98\xdc\xd0%\xe9\u06dd\xe5\xe4\xa5\xd6/<\xca}\xf3s\x1bɸ\x9dz\xaa.\xf4\x87/~\xfb\x1a]\x8f!\xf0\rp\v(\x13UHJ\x1a9c@\x8bxv\xc4\v2\xc4\xfa\xbd\xba\xa1,\xb2XB,H\x12\xb9\x9c\xc8/5'|e\\\xbc\x15\x1b-\xcfP\x15\x13\x8e\xb9n\xedz\x04?\xb7U\xa9\x91\xb1g\x9e\x15\x19\xb0\xcc1\"\x9a\xe4.\xda\xe0\x19\xb6e\x00\x9e\x18\xb7\xa1\x92\xce_ \x82U\xd1 \x13\x95\xe5\x02-\xc2\x1a7J\x93\xbe\x1b\x9eb\xe5\xfa\x83\\\xa8\xe1{\xc7nc\xb0a\\\x14:\xda\xea\xce\xe4Ƽ\x13R0<\xaf{\xf0\x89EaA\xe4\x8bJGG\x87\xb4S\x9e \xd7s\x02\xda[\x8d\xaf\x1d>\xe6\x9a;YTS\x11\xe4\x04D\x8a/\xdb\x11d\x10Q&\x0fC!\xe4\x04L\xc2\xe2=\x84|\x0f!\xe3྇\x90\xef!\xe4t{\x0f!\xdfCȘ\t\xef!\xe4{\b9\x1f\x85\xff~\b9\x8dقr\x9f\x83?G`\x13UB0\x8e\xec\xe8*\xa1\x1a\xe6B\x14Ƣ\x8e\xaf\xb9\xbd\xea\x9f\xd7S\u007f\x9d\xf8!\v\xfaV\xa7_6\xear\x8b\xdaeUE\xb8N\xd9JE\xf1\xf5\xfa\x93\xd1qdq\xedP\x9dv\\)\xd7T\x01W\xbb\x06\xb9*\x9e*\x8b\x90\xfb\xadFX:p\xcb\u007f\xf1Ѭ\x06j\xd7aQd^b\xfb;\xaaO\x8e*\xb1\x9a(\xac\x1a/\xe1V\xe5\x1a\x03Tl%\xe0\xdb\xe4ӭ2\xe2\xdf!\xf5&k\x9f\x86+\x9e\u008d\x1cZ\xb6\xff\xb8l\xffbU\xa8\u007f\x82'nw\xbd\x9b\xa2oP\xdcqQn\x9b\x85ѥ,\x86o\xbc\xbaT\x05\xa5Ar\xd1_\xd3\xe0\b^\xceo\x91\x1b\xbe\xe5\xfeP\xfa\"\xfd\x9d:&\xc5\xd6H\xbd\xb82\xaa]\xf74h\xe0\xe7_\xe0\xcd)+\x8f\xaf}\x9a*U\x9aS\xf1Ԭf\x1a\x01\x19[\xe7\x14w❬izA%St]\xe5\xab\\W\xc6\xd5*\xbdE\x85Ҍ\xba\xa4v\xbd\xd1\x04\xdcy\xd5H\x91d\x8a\xa9<\x9a]o\x14j{&\xf6\x13Qe4X=4\x01\xba\xa7\x8ei\xbafh\x8a\xfc-T^\xa5R\xe8\x05\xf5A\xaf[G\xfcZQ\xf7X\xb5OD\x8dOD\\>\x85iD\x15ϼڝ\b\x1a\xbe\xb0N\xa7\xaa\xc2\x19\\{nuN\xbb\xf6f\x10lLM\xce@\xc5\xcd \xcc\xd1J\x9c\xd8:\x9bA\xe8\x93\xee{BrF\u007fV:E=\x114\xc7\xcb̄\xbc\xb4\x93_\x9d\x95;_5\x94_úQ\xcd`\xbc\x9fN\xaa\xaa\xb9O\xe0g.SO^'#\r\xb7L\x9f\xceS]P\x15#\x90\x94\xf6B,C\xb0\xce!\xc0`Μ\xbdJa}\xf0\xa9\a\xb3\x84K\x96\xec\xda\x03{A\xee\x98q\aˌY8\xad\xceS\xe7\xe5<\xd7s\xba\x04\xf8\xaa\xaa\xe3k\xf3k8ó\\\xf4\xab}a\x10N\xdb`^~<\x18\x90\x13#Ynv\xaa\xfc\xf4y\xf2\x84p\xd7\x1e\xdfsD/?|N\x84*\xd2\n\xfe {\x99<\xc0\xed\x03\x05!\xf4AhR\u007f*\x1b\u008c2\xe4\xef~I\xdb\xff\x15\xfb\f\x92\f\x1dٍU\x9am\xf1Z%\x8dg>\xc6h\xd2\x1e\xdfz\xc5!\x18\x892)\x17\xaa\xd7\xfa\x8f7aG]pu.>\xe8N\x9d\xd7p\x98\xf6ۏQ\x8d\xb5VLn\xea\xfe\xfe\xdao\xc4\xf2\f\x97_\n\x9f.Y\xe4L\x1bt\xb4-7\xe8'\xad\x87\x1cɮ\xf9\x12\xce\xe7.\xfe͇pf\xef\xc2\u007fE_\ndI\xaei\x11~\xe8\x9f\xd78\xa15\x98F\xfa<$\xbbC\x90\x981*\xe1dM\xdc\xf9\xd8'\xdc\xdf\xe2\xc3\xef\xe1\xc0aP\xe9\v\x83ߞ$\xea梁\x99+\xe9\xf92\xfa\xbc\xc3/G\xd3Jf\xf6\x19\x00g\xb9:Ï=\x94\xac^F\xa2\a\x84\xbc\xe9%R\x95\xcf\\t\x895\xaa\xd7C:\xdd\x17\xe2-\xfaޖXT\x0f]\x9cL\xd0ѿ\xbe4\xfdR\x88\u007f\xf6*a\xb9-tp\u007fI\xa1\xe9\xabw\a\x02\xfd\xc7\xe1\xf3\xdf\n\xa9\x9f}\x1a\xe5\xd9u5\xac>\xc0\xd6oB}\x1ex\x13\xea\xae\xff\xd5\r\x18z\x8e\xc3{>\xff\xb6\xd3\xc2\xc1\x9eϴ\x1e\xf9\xa6W\x01Fww\xebFT\x97\x9d\x81\xac4\xad|K``'}\xb7Y\v\xb8\xc1\xa7\xa3\xbe\xe6#e\xcd\xfe\xaf\x8c\vL\x1f\xaa\x87\xa0b7U?\x1dE\xf7\x83G:\xdf6S\x9d\xc1\x9d$&\x13\xa2\x01\xcf\xdf\x05\x1a\xf8\u007f~\xec[(3\x91\xb8\x9d\xfc\xa9\xf3ۀ\xe1\x191:\xfd\x06\xa7GI:]\xf5\xfbt\x1f\xeb\xff\xc2{r\xfe\xa1\xb0\xbd\xbfV\xa7\a\xba҆\xac\x04g\x1czj\xcdcI\x82\xb9\rI\xf2\xe6\xc3a\xa7\xa7\xf4O\xf9.\x18\xfd\x9b(\xe9C]\xb3\x82\xbf\xfd\xfd\x04\x82\xe3|(\xf1p\x9d\xff\x0e\x00\x00\xff\xff\xee\xf9\n5\xcbO\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=\x8f\xe36\x13\xee\xf5+\x06\xf7\x16\u05fc\x92\xefpM\xa0.p\x12`\xf3\xb1Y\xac/\xdb\x04)hjd3K\x91\ng\xe8\x8d\x13\xe4\xbf\a\x1cJ\xb6%˷wE\xd4i8\x1f\x0f\x9f\xf9\xe0\x14eY\x16\xaa7O\x18\xc8xW\x83\xea\r\xfe\xc9\xe8\xd2\x1fU\xcf_Qe\xfc\xea\xf0~\x8b\xac\xde\x17\xcf\xc655\xac#\xb1\xef\x1e\x91|\f\x1a\xbf\xc1\xd68\xc3ƻ\xa2CV\x8dbU\x17\x00\xca9\xcf*\x89)\xfd\x02h\xef8xk1\x94;t\xd5s\xdc\xe26\x1a\xdb`\x90\bc\xfcû\xeaC\xf5\xae\x00\xd0\x01\xc5\xfc\xa3\xe9\x90Xu}\r.Z[\x008\xd5a\r\x84!\x19\xb1\xe2H\x01\xff\x88HL\xd5\x01-\x06_\x19_P\x8fZp4\x8d\x80S\xf6!\x18\xc7\x18\xd6\xde\xc6.\x83*\xe1\xfb\xcd\xcf\xf7\x0f\x8a\xf75T\xd9S\xd5\xef\x15\xa1\x00n\x90t0=\v\xac\x8dD\x83\x8d(\xc1c\x8e\a\xd9\x06(\xea=(\x82{|Y=\x04\xaf\x91\b\x1b\xf1\x91\xa1f+\x11\xf0\xb1O\xd89\x18\xb7+\x00v\xc1Ǿ\x863\xecl2P\x96\xe9Ρ\xb3\x8f!\xb0\x9cZC\xfc\xc3-\x8d\x1f͠\xd5\xdb\x18\x94]\xa6K\x14h\xef\x03ߟ\x83\x96@\x14\xf2\x89q\xbbhUX4.\x00\xfa\x80r\xf0\x8b{v\xfe\xc5}g\xd06TC\xab\xac0Hڧ\xbb\x8a\xeb^ia\x84\xe26\fu3\x84\xcbNk\xf8\xfb\x9f\x02ࠬi$\xeb\xf9\xd0\xf7\xe8\xbe~\xb8{\xfa\xb0\xd1{\xecT\x16.ffry0\x04\n\x06\xa0\xc0\x1e\x94N9\x01\x1dC@wJ\x9bq\xad\x0f\x9d\x84\x1b\x1c\x03\xa8\xad\x8f\f\xbcGx\x92\x9c\fW\xaf\x06\x85>\xf8\x1e\x03\x9b\x11\xbd\x98\x9c\xbb\xe7$\x9ba|\x9b.\x91u\xa0I\xfd\x82$1\x86\xaa\xc7\x06H.\b\xbe\x05\xde\x1b\x82\x80B\xae\xe3):\xe1\xa4\x05\xe5\xc0o\u007fG\xcd\xd5p{JY\x8c\xb6IMv\xc0\xc0\x10P\xfb\x9d3\u007f\x9d<S\xa2!\x85\xb4\x8a\xc7\x02\x1a?i\v\xa7l\xa2?\xe2\xffA\xb9\x06:u\x84\x80)\x06Dw\xe1MT\xa8\x82\x9f|@!\xb0\x86=sO\xf5j\xb53<\xce\v\xed\xbb.:\xc3Ǖt\xbd\xd9F\xf6\x81V\r\x1eЮ\xc8\xecJ\x15\xf4\xde0j\x8e\x01W\xaa7\xa5\x00w2.\xaa\xae\xf9ߩH\xde^ \x9d\xf5N\xfe\xa4Gn\xf2\x9e\xfa#WC6\xcb\xf8\xcf\xf4&Qb\xe5\xf1\xdb\xcdG\x18\x83J\n\xa6\x9c\v\xdbg3:\x13\x9f\x882\xaeŐ\x13\xd7\x06߉GtM\xef\x8d˵\xa4\xadA7%\x9d\xe2\xb63Lc\x95\xa6\xfcT\xb0\x96\xa9\t[\x84\xd87\x8a\xb1\xa9\xe0\xce\xc1Zuh\u05ca\xf0?\xa7=1Le\xa2\xf4u\xe2/\x87\xfdT1\xb3u\x12\x8f\x93x1C\vݻ\xe9Q\xa7\x9c%⒭i\x8d\x966\x80\xd6\aPK&ի\x18\xf2\x94\xf9\x12\x14Ì\xc88f\x93#\xf5\xe0k8\x96F\x85\xc8\xd3\x033\x15\xcd\xd0<$\x8dydkZ\xd4Gm1;ȓ\x02_\x03\x91>t\xb1\x9b\xc7+\xd3[u%\x9b\xbe]\xaf\xe4\x1f\xf2\xe3\xb23\x8e>}\x9b\xac#\xcf\xd5\xe5Ƚ\x18\xb5\x83\x1b\bѹԑ\xde%\xf1\xcc)L'\xf2\xec\xd40vW8\x16\x91ܹ\xd6\xcb2\xa2RHŹOpH\xea\x10##\xbarw+\xa7\xf9\x9b\x8f\xa2\xcf 0\u007f\xb2%|\xb9a\x1a\x1d&\xe0B\xccR\xb0,\x88S\xa4+\xf1b\xc7\fȢ\xb5jk\xb1\x06\x0eqn\x99\xedT\b\xea8\xad\x8a\xb1\x8cΫ\xdb'\v\xe4J=\xd5\xfe\xcb\x1eݭ\n\x87\x17EK\xb9\xc9n`{\xbce\xb8>\xed\xa0\xf3&\xc9eYC\x9a\xba%\x9b+\x96>\x83\x88\x85,\xe5R]\xd8\x0e\xaeH\xd8\\j\x8e\xbd?)\xf8qY\x98#\xbf\x11|!\xa93\xd1y\xe5~\u007f\xfe\x1b\xf6⼷\xca\xc1p\x8b\xe6\xe2\xe6\xc4>\xa8\xdd\xc8\xc5y\xb6\xa65\xabgl\xee\xe7+\xec\x9b7\x93]T~\xb5wy3\xa7\x1a~\xfd\xad\xc8^\xb1y\x1aq$\xe1\xbf\x01\x00\x00\xff\xff\x8a\x80\xfd\r\x9e\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WO\x8f۶\x13\xbd\xfbS\f\x92\xc3^\"9\x8b\\~\xd0\xe5\x87Ŧ\x05\xd2\xe6\xcf\"\xde\xec\xa5\xe8\x81\x16G\x16k\x8aTgHoݢ߽\x18RZ۲\x9cMPT\x17C\xf4p\xf8\xe6ͼ\x19jQ\x14\xc5B\xf5\xe6\x01\x89\x8dw\x15\xa8\xde\xe0\x1f\x01\x9d\xbcq\xb9\xfd\x1f\x97\xc6/w\xd7k\f\xeaz\xb15NWp\x1b9\xf8\xee3\xb2\x8fT\xe3[l\x8c3\xc1x\xb7\xe80(\xad\x82\xaa\x16\x00\xca9\x1f\x94,\xb3\xbc\x02\xd4\xde\x05\xf2\xd6\"\x15\x1bt\xe56\xaeq\x1d\x8d\xd5H\xe9\x84\xf1\xfc\xdd\xeb\xf2M\xf9z\x01P\x13\xa6\xed\xf7\xa6C\x0e\xaa\xeb+p\xd1\xda\x05\x80S\x1dV\xb0\xf36v\xc8N\xf5\xdc\xfa`}\x9d\x0f+wh\x91|i\xfc\x82{\xac\x13\x14\xad\x13>e\xefȸ\x80t+[3\xae\x02~Z}\xfax\xa7B[A)\x1bʞ\xfc\xceh\xa4\x04:\x1fuw\xbc\x14\xf6=V\xc0\x81\x8c\xdbL\x1d\x8c\x04\x94g\xe0\x8f\xbc\xddl\xf0ȑVA^7\xe4c_\xc1\x01|\xb6\x1e\xb8˼?\xa4\x88WC\xc4\uf1c8\x93\x815\x1c~\xfe\x8a\xd1{\xc3!\x19\xf66\x92\xb2\x17\xd9K6l\xdc&ZE\x97\xac\x16\x00=!#\xed\xf0\x8b\xdb:\xff\xe8~4h5W\xd0(\xcb\x12\r\xd7^b\xfb(\x11\xf4\xaaF-kqMC\xc9p\x05\u007f\xfd\xbd\x00\xd8)ktr\x99\xc3\xf4=\xba\x9b\xbbw\x0foVu\x8b\x9dʋ\x00\x1a\xb9&\xd3'\xbb\v\xf1\x81aP0\x02\x84\xc7\x16\t\xe1!\x91\t\x1c<!\x0f\xb1\f.\x01Ơ\xb8\x1c\x96z\xf2=R0#\xe7\xf2\x1c\t\xe3im\x82\xe7J\x00g\x1b\xd0\"\x05d\b-\xc2PШ\x81S0\xe0\x1b\b\xada L\xe4\xb9p\xc8\xde\xf8\xf8\x06\x94\x03\xbf\xfe\r\xebP\xc2J\b&\x06n}\xb4Z\xf4\xb3C\n@X\xfb\x8d3\u007f>yf\b>\x1diU\xc0!\xcd\xe3\x93\xca\xdd)+TG|\x05\xcai\xe8\xd4\x1e\b\xe5\f\x88\xee\xc8[2\xe1\x12>xB0\xae\xf1\x15\xb4!\xf4\\-\x97\x1b\x13\xc6VP\xfb\xae\x8b΄\xfd2\tڬc\xf0\xc4K\x8d;\xb4K6\x9bBQݚ\x80u\x88\x84K՛\"\x01wY\x9c\x9d~\xf9T\x04WGH'\xa2\xcaO\xaa\xfa\x8b\xbcK\xb9\xe7\xb4\xe7m\x19\xff\x81^Y\x12V>\xff\xb0\xba\x87\xf1Д\x82S\xce\x13ۇm| ^\x882\xaeAʉk\xc8w\xc9#:\xdd{\xe3Bz\xa9\xadAwJ:\xc7ug\x82d\xfa\xf7\x88\x1c$?%ܦ\x86\bk\x84؋\xe6u\t\xef\x1cܪ\x0e\xed\xadb\xfc\xcfi\x17\x86\xb9\x10J\x9f'\xfe\xb8\x8f\x9f\x1af\xb6\x0e\"\x1a:\xecl\x86敺\xea\xb1>\x11\x8a\xf80\x8d\x19\x94\xdbx\x02u\xc2\xe6\xa0\xe2yo\xe5\x91需!\x0f\x9e\xc6lN\xd7N\x87\xc2\xfc\xbe\x8b\xf4\xcc\xc4z\x9bΐr\x94\x00\xc6\x11R\x8c\xb1\r\x18\"\rA\xa6vY.\xe6Κ0\x9c\x02 ԒIe\xa7\x00O1<\x99\xa5Y\xab\x8c\xcb\x04\x1f\xb6\xa7\xf2\xa2n\xe8\x98.\xa0ө5Op\xf8T\xa5\x8c\x1a\x1eMhs\xf1\xdb\xe3i\xf3<\xe7\xf2lq\u007f\xbe8\xc1|ߢ\xd8\xe5\xe6\x88\xc0X\x13\x06A\xc0hE\x96\xa2\xb9\x12\xe0C\xe4$\x1d\x95GƌW\x18\xf7nq?%\xf6\x99D\x0es\xf99\xa8W2\xcdF\xa0\x84\r\x12\xba0+[\xb9ڐÀI\xb9\xda\xd7,\xa2\xad\xb1\x0f\xbc\xf4;\xa4\x9d\xc1\xc7壧\xadq\x9bB(.r\xd2y\x99F\xfe\xf2e\xfa\x99\r\xf2\xfe\xd3\xdbO\x15\xdch\r>\xb4H\x92\xa5&ڱ\xa0\x8e\xe6ի\xd4=_A4\xfa\xffW\xdfˇ\xef\xb32\x9e\xe5d\x95\xea{/\xf36\xc1\x11jV9\x0f\x9e@z\xa0$\xb7\x1b\xb2\x97U?\x97\xbd\x8cf\xed\xbdE5-1领PO\xc1\x14\xe2\xfb[%4*\xf2\xab\x02\x1a\xafy\"c\x89d\xdc4&=\xdf \xd2}Bmp^\xbeg\x8c\xce\xc1/\xe0\xe4\x92\xf9\x15\xec\x1cT\x88\xfc\xbd-6m\x1a,\xd7C\x9b\xad#I\xc1\x0e\x1e\xc17'\xf0տo\xb3}\xab\xf8LE߀\xf6N\xf6\x8d\x94[\xd3`\xbd\xaf-fw\xe9FtV/\xdf\fU\x1et\xb1;/\x9d\x9b\x9d2V\xad-\x9e\xfd\xf3ũ\v\xff]H\xf0L\xde&K\x87o\x9b\xeb\xc3\xdb\xf0\xf51|\xcb\\狷\\<t\x05\x81b>|(\xb5a\xe5P\f\xaa\x96n\x82\xfa\xe3\xf4\x13\xe1ŋ\x93[~z\xad\xbdˣ\x8e+\xf8\xe5\xd7E\xf6\x8a\xfaa\xc4!\x8b\xff\x04\x00\x00\xff\xff\xf0h\x1a\xc0\a\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=\xcbr#\xb9\x91w~E\x86\xf6\xd0\xde\b\xb14\x13\xbel\xf0\xa6Qkb\xb5nw+F\xb2\xf6\xe0\xf0\x01\xacJ\x92\xb0P@\r\x80\xa2\x9av\xf8\xdf7\x12\x8fz\xbf\xa8\x96fwb\x9bՇQ\x11H$\xf2\x8dD2g\xb5^\xafW\xac\xe0O\xa8\rWr\x03\xac\xe0\xf8բ\xa4\xbfL\xf2\xfc\x1f&\xe1\xea\xea\xf8\xe3\x16-\xfbq\xf5\xcce\xb6\x81\x9b\xd2X\x95\xff\x82F\x95:ŏ\xb8\xe3\x92[\xae\xe4*G\xcb2f\xd9f\x05\xc0\xa4T\x96\xd1kC\x7f\x02\xa4JZ\xad\x84@\xbdޣL\x9e\xcb-nK.2\xd4n\x85\xb8\xfe\xf1\x87\xe4\x8f\xc9\x0f+\x80T\xa3\x9b\xfe\xc8s4\x96\xe5\xc5\x06d)\xc4\n@\xb2\x1c7\xb0e\xe9sY\x98\xe4\x88\x02\xb5J\xb8Z\x99\x02SZ\x8be\x99Ç\x89{ͥE}\xa3D\x99{<\xd6\xf0_\x0f_>\xdf3{\xd8@b,\xb3\xa5I\x8a\x033\xe8p\xccФ\x9a\x174y\x03?\xb9\x05\xc0\x0f\x02S\xa6\a`\x06\xee\xe4\xbdV{\x8d\xc6\\ݨ\xbc\x10h1ss=V\x0fn\xb4{aO\x05n\xc0X\xcd\xe5~de\xd4Zi\xd3_\xfaF\x95҂\xda\x01\x13\x02\xdc \xc8\xd1\x18\xb6G\x03\xf6\xc0,\xbc\xa0FأD\xcd,f\x90\x95\xb4\b\xe0WLK\x82\xe0 \x02\x01\xb0\an\x02\xa9\x1aX\xde\xd6\xebz,\x89L{\xd4#h\xbe0-\xb9\xdc\xcf!\x1a\x86\xbd-\xaa\xff\xdd\\{\t\xb2\xc62m+\xa1\xe9\xa3L_\xc1\xcb\x01esAxa\x868\xad\xdbܼ!\x19\fo\xfc\xda\x19\xb3\xd8[\xb8\xc041Vi\xb6\xc7O*eնZ\xeb~f9\xfamb\x14\xad\a?\a\xe2$BKc\v/sP\xa5\xc8`\x8b@\v\xb4\x90\xebΞ\x15\xba\xa8\x9eIO\xb5\x1aP\xaf\xf7\xd8\xdf\xee^\xab\xb2\xd8@\xadj\x9e@A\xb3\xbdU\xf8\xa9\xe6\x9c\xe0\xc6\xfe\xa9\xf1\xf2\x137\xd6}Q\x88R3Q\xe9\xae{g\xb8ܗ\x82\xe9\xf8v\x05Ph4\xa8\x8f\xf8\x17\xf9,Ջ\xfc\x99\xa3\xc8\xcc\x06vL8=5\xa9\"܈\xa0\xa6`\xa9#\x8a)\xb7:\x18$\xb3\x81\x7f\xfek\x05pd\x82g\x8e\x19\x1eMU\xa0\xbc\xbe\xbf{\xfa\xe3Cz\xc0\xdc\x19\xa91\x9d\xe7\x06\x18<\xb9\xddB\x04\xeb\x15O\xa3CNZ\x92n\x84\x94\x15\xb6Ԏ\xaf\x7f*\xb7\xa8%Z4\x010@*JcQ\x93`Y\x04f\x81A\xa1\xb8\xb4\xc0%X\x12\xc3?\\\xdf߁\xda\xfe\x1dSk\x80\xc9\f\x981*\xe5$sp$\xa3Elg\x16\xff=\t0\v\xad\nԖG\xd2\xd3Ӱ\xdeջζ>о\xfd\x18\xc8\xc8^;;\x82p\xf4\xef0\x03\xe3hR\xa9a\xb5\xcdZ\xb2⇬\x92\fH'\xf0@|\xd2&\xcai\xaa\xe4\x115\x91)U{\xc9\xffQA6`\x95[R0\x8bƶ \x92>k\xc9\x04q\xac\xc4KG\x88\x9c\x9d@#\x11\x06Jـ憘\x04\xfe\xac4\x02\x97;\xb5\x81\x83\xb5\x85\xd9\\]\xed\xb9\x8d\xfe*Uy^JnOW\xce\xeb\xf0mi\x956W\x19\x1eQ\\\x19\xbe_3\x9d\x1e\xb8Ŕ\x98w\xc5\n\xbev\x88KڬI\xf2\xec\xdf*Y\xfa\xd0\xc0\xb4\xa3[\xee\x9d\x17\xfeQ\xba\x93\x16xi\xf2\xd3\xfc\x16k)\"sIT\xf9\xe5\xf6\xe1\xb1)i\xbc\x16\"z<\xb5\x1b\xc2W\x13\x9e\b\xc5\xe5\x0e\xb57\x1b;\xadrGg\x94\x99\x975\xfa#\x15\x1ce\x9b\xe8\xa6\xdc\xe6\xdc\x1a\xd0\xf8k\x89\x86\xc4Y%p\xe3\xbc6Y\x9b\xb2 \xd5\xcf\x12\xb8\x93p\xc3r\x147\xcc໓\x9d(l\xd6D\xd2y\xc27\x83\x8d\xf8\xf1\x03=\xb5\xaa\xd71,\x18䐷Z\x0f\x05\xa6-Š9|ǃY\xde)]\xdb\x03o\xa5\xa2B\x8e)%=\x19\xeeX)\xec\x93Sd\xf3\xa8~Acy\v\x95\x1e:\x1f\a\xa7DtА\x87\xb0\a\xd4$+\xee\v\xa7v\x1d\x88\xe0\x18h0s:Ǟ\x11X\xc0:z\xeaBE\xfbb`{\x8a\x886\xf7TSs\xab\x94@ֶ\x01\xf85\x15e\x86Ye\x82\xcd\xe4\xaen{\xc3]4ȸ$\xcd oA\x88\xc9\xfa[gj\x99\xc6\x0eP\x00\x92N.=4gE\x0f8\xc0\x10\xfa\xc7-\xe6=\xacFD)\xc0.\x85`[\x81\x1b\xb0\xba\xec.\xed\xe71\xad\xd9i\x90\x121\x1a^F\x88jt\xb0\r\x82\xa7·T\x16\xc0\xd1\xe2wD\x86\x83R\xcf\xd3[\xffO\x1aQ[0H\xdd!\x02\xb6x`G\xaet\xe0y\x1d\xee\xf8X6\x04<͇Y\xc8\xf8n\x87\x1a\xa5\x05\x17\xba\x1bP\xbb\t\x12\x8c\xa9'=\x91\xe0\x03_u\xf0\xafY\xc64\xfa\xfd\x8e\xa1LJ*\x9dX\xf6\xa9럲\x00.3~\xe4Y\xc9\x04pi,\x93\x04\x9aԳ©\xbb\x8f\tv\xf6\xb0\xf5f-\xe2L\xb4o\x998%\x11\x94\x86\x9c\x9ch\x7f\xa8Y\r\x80\a\x18\xdd\ue591\xadQ^\fu)Є\x852g9k\xbd\xbe\x1c\x01\\q\xc1\xfb~\xc1\xb6(\xc0\xa0\xc0\xd4*=D\x86i\xa6.\xb5Q#\xb4\x1b\xb0V\xb5\xfd\xa5-6\r\x95\x1a\x85\t\xf0r\xe0\xe9\xc1\xbbe\x92\x17g\xc5!Sh\x9c\x19cE!NÛ\x9b\xe1\xf4\xac\n/T\xe6y\xb5\xeeS3\xcaɹĬ\xe65|\x19Ѳb\xfd\xff\x1fRrٕ\xaf\x85\xb4\xbc\xebM|K\xc1$\"r4\t\xdc\xed\x00\xf3\u009e.\x81\xdb\xf8\x96\"\t\xe6\x92/cO\xbd\xf6\xef\x8e\x11\xe7\xca\xf4]w\xde\x1b\xca\xf47r\xa1Z\xfaw\xc3\x04g\xec\x1f\x82\xad_ȀO\xcd9\x97\xc0w\x15\x03\xb2K\xd8qaQw81\n\x17H\xb2'9\xf1\xad$\x98\xf7T\xf4\xe4̦\x87ۯt\xea6u\xcet\x115\xbaS\x817\xa3\xea\xb63\x9d\x84J\xe1Я%ט\xfb#\xe6\xe3\x01[o\\\xe4s\xfd\xf9#f\xe3ҵH\xc2z[\xb8\xee\xa0\xd9\\6\x84\xc8\xcb6\x10\x82\x94\xeat\xe1\x8e\xdb\xe6\x12\x18<\xe3\xc9G\x17L\x021\x84\xd124x\x16\xa2F\x97\xb3p\xaa\xfd\x8c'\a$\xa4!f\xe6.c}\xc8#\xe0i~P\x87l\x84\r7!\xadBl\xa6\x17\xb4'\xf7j!\xcfCT]Y\x98iޞa\"\xe2\x13\xa9}\xf6\xf6*6\xd5y\x0f\xcf\xc8\x0f\x94\xb6\x10\xeeln\x0e\xbcX\x00ש9I\x91˪\xc7$\xd2\x13e\b+\xfc|d\x7f'/ᳲw\xf2r\xb5\x00*\xdc~\xe5&\xe4\xee>*4\x9f\x95uoޜ\x88\x1e\xe5\xb3I\xe8\xa79\x15\x92\xde\f\xd3\xfe\x9b\xb9\xa8Y!\xf6\xff\xeevN\xa6*\x96p\xba\t\xa13\x84\xa7\x95\xfb2,6e\xed۟\xbc4\x96N\x12Rɵsv\xc9\xd0:\x81\xc4\v\x05\xb9Ʌ>ZՒ~\xb9E\x10\x1f)Nr\x9b\":j,\x04K\xeb\x8b\fF\x9e\x92Y\xdc\xf3\x14r\xd4!{>\xf7\x14d\xb3\x97,\xbfȖ\xbeB\x9e\x96\xb8\xe6\xf8\tƸ\x95\xe6\x1cz֤\x9b\xb3c\"kg\x06\x0e\xa6\xf2^\xbf\x0f\xe7$]\xdc0C\xcd\xe6\xe5\xe1R뽘\xf2-\xddl\xa0D\x82\xc5 g\x05i\xe7?\xc9U9\xa1\xfd\x17\x14\x8c\xebY\r\xbdvw(\x02[3CV\xa8\xb9\b\xc1\xe7\x06\x88\x9bG&\xba\t\xe1\xfe\x87L\xa6\x04\x14.\x1e ̺\x91\xc6%\xbc\x1c\x94Ab;\xec\xe8\x92\x06:y\xeb\xfes\U0004c9cb˞\x8e_\xdc\xc9\v\xef\x9e{\x1a\x1b}\xf9\f`%\xc5\t.\xdcׇ̋.\x8b\xa4n\xc1 :\rmV\x8bĀ\x8e\x81ы\xcb\xea\x8e0\x84\xa2\xc9\xea\x1bd\xaeP\xc6.D\xe2^\x19\xebR?\xed\xe0q 74}\xa6\t9!`;\x7f\xef\xa5t\xbc\xe1 C\xd6IU\x12\x97\f\x0e&8{\x10\xb3\x00\x92\xb2\xd7\x17\xb5\x8e\xfa\xb3\xfd\x85\xbf\xf6\xa0\xff\x06\x96\xd27S\xd2B^\xbe\xd0*Ec\xa6\xc4a\xd6\xf2\xb6\bاT\x95lc\x8e\x93.\x156\x9d\xdc;7l$\xd2L\x8f\xe8 y\xfb\xb5\x91\x03d\xd2]\xc2ψ\xd9y\x18\xd1C\x97@\xac}'\xb6\b\xb9\x1b?/\xaaB\x00\xe3l\x02\xd3\xfb\x92lМ\r\b\x9a\xa1\xa2\xd0\xfc\xef:\u061c\xcb;'C\xf0㛺c\x88\x97'x~H}\x13g\xd6d\xae^x\xdd,T\xb6\x9a\x84\x17\x9eX\xaaPs\xaa\x9f\x19v\xe1\x1c%\xe8\xea\xe3\xf9\"\xd8\x01\x8f\x0f\x06v\\\x9b\xea8\xe7\xb1.'\xb5\xf6\x95\xdcRҕĜM\xcf/~^\xb5A\xb2\xda/\xf1\xa6p\xe4rn\xe8q\xd7 H\x99\fn\x01e\xaaJ\xba\x13wQ\xbb/\xff\t\xf52r߿\x1c\x1e\xfb,QlzP\x96\xf9\x92\x8d\xaf\x9d\xf4p9\x91먟5\xfc̸X͎;\x8fMT4\xa1J\xbb\x99\x1d\xd8a\x13\x15\xba\xa8\xd2V\xb6\x8f\x04,g_y^\xe6\xc0r\"\xf6\x02\x88@\x1e\x910h\xf3\x17^\x18\xb7κ\x13T\":\x9d5\xd3P\x1b\xb6\b\xee\x16wt\x13\x93*ix\x86\x95\xcb\f<W\x12\x18\xec\x18\x17\xa5\xc6\xe4m)\xba<\xb2\x0fJ>3nQ\xf8\xb4lٵ3\xe2\xabo\\kު\x16zi\xa0v\xaf\xf1-C\xa4Bs\x92\x19\xf5\xb6QR\x10%&O\xdfä\xefa\xd2\xf70\xe9{\x98\xf4=L\xfa\x1e&}\x0f\x93\xbe\x87I\xdf\x12&Mc\xb2v\x85\a\xabW\xac>{\x85:\x8e\xd8(\xe4p\xab\x7f\xe3k\xafc\xa8\xd1\xf3]C7\xfa\xdd9\x03u\x97\xa1\xa4{\xedj\xd0\xfb|\x8eqKU\x10\xbdŪ\xcc\xc0\t\x7f\x14^wyՉ\xf4Vg\x10g\xbc6\x93\xf7\xaaD6\xab\xf3\x8aJ\xda5\x89UaG,JTq\x89\x0e\xd8X\xa6싐\x9b\x15\f\x94\xb4\xab\xebC(\x94\xad\xb0LV\x8b\xe2\x8c\te]@\xa6\xbe\xfc\xc4\xe5\xcf\x12\x8f\xc5e\x9b\xe3\x14j3\xbcC\xa2Zx\xfe\x0fPh\xb2.c\xbc\x1a\xc3S\x86j\xb3\x8f?&\xedo\xac\n\xb5\x19\xf0\xc2\xed\xa1\x03\xd1EJ\x12\xe8\xc8\"\xf7\xcd\xe2\xc8(SV\rR\x8e\xae %\x17\x97\x83u1qn\x8b\x9c\xf0\xc5\xe1\xcdDr\x0e\x99\xa6B\xfb\xee\xb5H\x7fD\x87b\xdd\tS\x15\x1b\xd1\xf6\xba\xc0>Y\r_P\x9es\xd91\"?\xdfP\x93Ѯ\xb9XM]`OVb\x9c]i1\x7fޚ\xac\xaaxE-E\xac\x93\x18\x85\t\x93\x15\x14\x13J\x1a\x9fH\x91\x85h/\xad\x91 \xb3\xcdFA\xc2y\x95\x11\x8d\xaa\x87ղ\x9b\xf8o\"\xc9\\\xedC\x8b K*\x1e\xbaU\x06\xa3\x90a\xb6\xcea\xbc\x86a\x02\xe8`uÒʅ\t\x98UM\xc3\x1b\xd6+\xccT)LX\x92ż\x1dw@\xf13\x17{\x8e\xd5\x1c\xccT\x1a\xccD\xa6SX5\xeeԇ\x90Z^A0C\x9f\x96\\/\xaf\x16\xa8\xea\x01\x06\xd7<\xb7F\xa0]\x050\brae\xc0\xc8\xdd\xff \xc8\x05\xf5\x0037\xfe\x83`'\x1d\xe3\x84D\x8c~\xa5t+\xc6\xe9\xf1\xb9\xc5\xc2/\x9d\xc1m\xb7?\x123u\x00B3\x86:/f\xeaA\xfa\x12\x869\xd1r\xb6A\x9e\xe2Oi\xa2Û\x8eE\xa1\x13\xfc\x11\xc3z\xbbL\x99\xfc`a\xab쁒^\xf1HՃ5bM\xa6c\x12OA\xf7\xee\xd7\x12\xf5\t\xd4\x11uU跚*\x90\x0e\x12cJ\xe1j\x02\x9bJ@\xdb\xe8\xc5h\xc3\tx/Mp-\xbd\xf5\xed\xe2砠\xa1\xe84r%\x81\xeb\xd8Ƞ\xfb\x8cL\x96\xaa\x9a\xbb:/\x04\xeanbhL\x87\xc4o\x1c\x9b\x9e\x1b\x9d\xcex\x95ii\xf8\xb6\b\xf5}b\xd4%Q\xeal\xf5ok\xdbo\x16\xa9NǪ\xb3\xee)X\xc0@\x9d\xc5\xe8\xbfU\xc4\xfa.1\xebҨu!q\xe6\xabv[\xa4y\xe3\xd8\xf5\x9d\xa2\xd7\xf7\x89_\xdf'\x82]Pi;io\xce\xe0\xf5t̸$\x96\x9d\xae\xa0\x9d\xad\x9c\x9d\x88_\x96\xe0\xd7p\x80\xc3\xe8-\x8fk\x17P\xac%\xf7o\x15۾Kt\xfb.\xf1\xed\xbbE\xb831\ue314L|\xf9\xaa$\xa2\xd2\x19\xea\x89,\xeb2\x91\x9a\x10\xa6\x96\x18}\xe9\xac\xd6H\xdf\xd7a\xb0ǩ\x99\xb5\xed\x13RU?(K\x81:xx\xdaS\xf9t\xc3\xf7\xd2\x17.%^\x87\x00u\xac4\x04\xb2\x93%6X02c\x19u`p\x97\xc3&\x81[\x96\x1e\xda\x03\xe1\xc0\f\xdd\x1c\xe4\x03\xbfT\xba\xa8\x92\xeaWq\x0e\xbd\xb9H\x00~V\xd5]E\x05\xcf\\\x82\xe1y!Nt9\f\x17\xed)\xe7\xb3{@L4\x92\xe0~b\xfd\xba\xe3\x16\xa3~\xa9\x86Eb\xca2ߢ&*\xe5\xcaP\x90\x95R\fgʔ\xaatwe?X\x0e\x1d\x84\xaa\xe3Jz\xc0\xac\x14\xa1I\x8f\xebo\x84Y\xab\x91\x92U\xf0\x8cX\xf4o\xd65\xee\x99\xce\x04\x9a\xd8F\x80kx|\xfc\x94\xc0\x9d\xf5G\xcc\x10H\x91\xa9\x88\xab\xc6\x05\xb6\xa7\x81\x10%\xe2\xe2\\\xe9?P\xab\xcbj\x1e馃\xb9gz\xcb\xf6\xb8N\xa9!Z\xda\xfc\x11}\x0f\\\x8dQ竜K\xba\x99\xdd\xc0\x0f\x9d/\xba]\xb2ꏑ\xac0\ae\xff\xac\x8e\xf8\xb1\xd3;\xa5ǥ\x87\xce\xe0\x81۰X\x1aA\xe6(\xf4\x16\xe9@\x04(\xa8ǐ\xb1\xc4O\xdfr\x04R\xc1xn\xc0](\xd3\xceO\x8e>\x98\xad\xcb\x02\n\x955\xef\xceRU\xf0\x81\x1e\x10\xad\x83-\x84\xd6[ \xaa6Z\xdc\x1eb\x87\x14j\xae\x80,#\xec,{\xa6\xf8A2ˏ]\x91\x86\x88\\$\x11)\x8b\xaa\xc3\xea\xb0T\xca$\xa1E\xb0\xa9\x1b\x17pI\xb7\x1a\x1a\xcdA\xf4}j\xa1Ց\x87\xfeJ\xb1\xdb\n\x97\xae6+\xa8\xe6e8Y\x13Z\xae'\x03\x95\xfa\xd5\xdd-R\xa1\xca\xfe\xe6\x1dXj\x9bw\xbe\xca\x0e\xdd\x17\xc6\r\x87\x9e3\x8bD\"\x8c\x1d\x92\x88\xd0qơ^\x13\xb3\x03\x14\x88\x1dD\x87\xfb'\xf7[>\xd7\xcd#\xad{\x99\x84\xc8>\x9cz\xab\xf4C\xfc\xfa\xa7\xb7\xbc/\xed4n\x9b\xde\x7f{l8d\xba;\xb7\xe8\xb5cUB\xfc)\a\v\xd8v\xa6\xae\xc6\v\x85\xa2TWJ@\x18\xf6\xdd\xf9\xa8W\xb4VLn\xe2\xf1\xf1\x93G\x9cjY\x93\x8f\xa5v\b\xad\v\xa6\r\x12\xfd\xe2\x86\xfc\xa4-\xfd\xe7A\xbdt \x02\b%\xf7\xcdfv5\xbe\xde\v\xf8\v\xef\xc5X{\x05\x89\x02\x16\xc9d&w\xf24<\xa7\x91\xb2h0\x85\x18\xe2L\xfcȬ\xceB\xd0\xec\xc5\xe6\x92m\ro\x92\xac\x16\x9d!F7;\x161\r:V\xea\x00W\xb6\xa0\x0fu\xb0r\x83b?\xbaP\xb4Vj\xd7$'t\xb0$\x95\x8b59\xfdm\x8ce)B\x85N\xab\x11\xe7\x14On\xfa\xe3ɝ+\x9dy\xa4H\xe8\xea~T/\xccT5@\x03\x01k\r\xccW\x14\xb9\xdf_\xa6\x14\xc1e\x80G\x94@\u0379\x18\x17\xe4E\x1c@\x934\x10psz0\x9b0BEQY\bŲ\xa8\xb9\x01\xb5\xd8\xe1\x8eB?\u05cdP\x7f0\xa3\x10\xe9G\t$\xeeC\xdb\xef\x1a?\x1f\xcc\xf9ފ\xeb\x01\x80\v\xec\u0600H\xb9\x9f\t\x98Iָ\x1a\xbcp\xc2J\xcf\xeb0\xda\x01\v\xf1,^\xd7^\x85@,\b\x96O\xfb\xb1\xd4R:ڣ\x16\x12ɍQ\x1f\xfanA\xa8=%\xb8)\xe3\x1c\xd4 z\xf7dq\x90\x83_\v\xae\xe7m\xf9m5\x8c(R7\x04\xad[@\xa2\xe0{N\x06\x91\x18ۉظ\x92\xc9o\xc2W\x0fu\xa0\xc1coC?7G\xc6\xc0:\b\xb3\x87\x12\xfb=6Î\x9c\xfd]\xe9~T\x9csI\xad5\xe8h\xe32(qj\xb2\x14oיk\x12\xdf{\x1a\x11\xf1lڪN\x9b\xd4d5_\x88\xb9\x86\xcf\xd8uQ\xfe'(\x98=U}@{\x03\xeaf\xbe\xbd\xaf\x82\"\xf7D\x7f\r\xf7L[΄8y\xf0\xbd\xefG^\x7fD\xb2dr\xbf\x98\x80\x01\xb3i\x1a\x86Au\u0380zb\x12\xafI\xaeٖ~\xf4\xd2T\xb8Za;P\xeb\xf5\x12J\x16\x86\x83\x94syM\x88\xe4\x01\xd1\xd85\xeevJ\x87\xf3\xd1zM\x05\xb9ޱ\xf4\xa0\xd2\xefV\xdcE\x94o(I\xcdl\xaa4^-\x9b.&\xd6Ȍ\x93M\v9;Q \xc1%KS\x8aO\xf0\xcaX&09G\xa3\xa6\xd2\xee\xce_\x93\xa2c\xf6\x97\x9e;\xeb\x11\xf9\xae9\xba\x7fbu\xc0<\xbd\\u\xb2\xb7z\x03\xe7\x01\xfa\xb7E\x94𢹵(;\xc7\x18K\x16F\b:v\xecX/p\x9a\xb6y\xf4Xe\x99\xb8\x1b\xcbf\xb6v\xf4X\r\x8d\xdbq\x93\xfb\x9bRĆ\xad#\xd4\x00Ljd\x17\xb2\xb5a&1.=0\xb9'\x01Ҫ\xdc\x1f\xa2\x04\x8ex\x8aA\xa8YI\bA!\xca=\x89t\xb8w\xb1\xa5\x96\x8d$d\xb8\x89\xc9\x1a\xa8\xb2\xf4\x19ʁ#~,\x96\xaf\xfa\x17_\x85vfk\xaaT\\\a\xfa\xbb\xbb\xadː\xcd\xd1\\Q\xc8\xe4\xce4\xa1\xa3\xd0\bX\xc7\xf6\xa2@I\xdd\xc1=.\xb3?\x9d\x99b\xe4hr\xa5\xdd\xd8z\xb3\x9a\xe0\xefCk\xe8L\xfc\x15\xda^SKY\x9f\x91\xea@\x06W\xcf\t7ݦєM\x92\xb1/\xb2KR\x06\xd6S\xe22\x1e\x91}\x1b\xa1\x1e\xc4V@\xd5\n\xa0ڨ\x9b\xdf\xc4\xc7֝\xa2o磨ڝ4㩪\x16\x94nzkx1\xf6\xf9\x03߭\x06[\ue904m\xd5\xde\xf9\xf5\xe7\x89W\xa5g\x83O\x9f\xdc\xee\x87ɀ\xc2E\x0fUl\x00\x1f\xe9J/%\xad\xec#\x7f/\x90\xfc\xbdAlG*\x1f\x06\x91\x1dҍ\xf6\x11\xd1\\[Kw\xee\x98M\xe2\xff42i\xcc\xf0\xb18\xa0\x034._\xe74\u008f\x19F\x0f\x85\x8b7R\x85\x1a\xe7l\xa4\x9a4\xb6\x91:y:\xe0\x8a\xaa3\xd7\x1b\xee*\xb4\xff\x9f֞\xd8\xce\x7f\xe0\x14\x12\xe6\xbf\xed9\xa4q\f\x89\xf8\xfdF\a\x91\x01;\xdey\x15\xd5\x0f\x8e?\xd6\x7f\x85\xffK\x05e+\xc2\x17\xc1Zf\r\xd5\x0e\xa8\x847u\x82\x80\xa5)\x92\xec~\xee6翸h\xf5\xdfw\x7f\xa6Jz_j6\xf0\u05ffQ\x0f}2\xd8YPK\xb3\x81\xbf\xfem\xf5?\x03\x00\x82\xb0\xb4\x94\x1fd\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYK\x8f\xe3\xb8\x11\xbe\xebW\x14f\x0f}i˳\xd9K\xa0K\xd0\xed\xc9\x02\x93\xf4l7ƽ\x9d\xc3f\x81\xa5ɒ͘\"\x15\x92\xb2\xd7\t\xf2߃\xa2HY\x96\xe4G\xe7\xb1-\x033\xe2\xa3Xϯ\xaa\xa8l6\x9be\xac\x96oh\x9d4\xba\x00VK\xfcգ\xa67\x97o\x7f\xefri\xe6\xbboW\xe8ٷ\xd9VjQ\xc0\xa2q\xdeT_љ\xc6r\xfc\x84\xa5\xd4\xd2K\xa3\xb3\n=\x13̳\"\x03`Z\x1b\xcfh\xd8\xd1+\x007\xda[\xa3\x14\xda\xd9\x1au\xbemV\xb8j\xa4\x12h\xc3\t\xe9\xfc\xdd\xc7\xfc\xbb\xfcc\x06\xc0-\x86\xed\xaf\xb2B\xe7YU\x17\xa0\x1b\xa52\x00\xcd*,`\xc5\xf8\xb6\xa9\x9d7\x96\xadQ\x19\x1e\x16\xbb|\x87\n\xadɥ\xc9\\\x8d\x9c\x8efB\x04\xf6\x98z\xb1R{\xb4\v\xa3\x9a\xaaek\x06\x7fZ>\xff\xf0\xc2\xfc\xa6\x80\x9c6\xe4\xb55;)\xd0\x06\x9e\x05:neM\xbb\vx\x893`J\xf0\x1b\x8c\f@\xe4 \xaco9K\vÐ?\xd4X\x80\xf3V\xea\xf5\xe4\x81f\xf57\xe4~\xd9R\xc9W\rߢ\x1f\x1f\xfe\x18\xc6\xc1\x1bh\x1cBi,\xb4\xfb&\x8e\x7f<\x92\xb8x\xb8g\xbeqy\xbda\x0e'\xcek\x85\x8bl\xc1S\xd4/\xb4\xbb\xc05|\x03\xcc\xc1ÎI\xc5V\n\xe7?j\x96\xfe\xdfWEG\xfd\x06V\x14s\xfe\x8d)):\xbb\x8f\xf9z\x1a\xad\x01\xe9\x829h7x\x1a\x18\x18\a!y\a\xec\x99\v$\x01v-\r\x14=f\x896\xbc\x9dL\xb4\\\xd3\xfb\x88gr\x16\xc69:\xf7ň\t\r\xbe\xa0\xad\xa4#\xa7v\xc1^c\x97\xe9\xf8\xea\xf1\xf0\x10(BG\xf2\x92\xdaR\xb8\xe5\xa3P\xe9\x13\\\xe3-\x92\b,Y\xa3&\x1c\xefS;q\x03\xebqeﴕ1\n\x99\xce\x00\xd6\xd64u\x01\xc7\xe0l\xa38BC\v+\x8f\xe1\x84\xe8q\xc9\xe1¼\x92\xce\xff\xf9\xfc\x9a'\xe9Z\xc6k\xd5X\xa6\xceACX\xe26\xc6\xfa\x1f\x8eG\xcf`\xe5\bS\x00\x9c\xd4\xebF1{f{\x06P[thw\xf8\xa3\xdej\xb3\xd7\xdfKT\xc2\x15P2\x15|\xdcqC\xb6\n\xc4kƃk\xb9fe#N\xc6\x03[_/\xe0\x9f\xff\xca:/$E\x87IS\xa3~x\xf9\xfc\xf6ݒo\xb0\n8:2Ȥ\n(\bXg\x14\xd8o\xd0\"\xbc\x05m\aoC\x17\xa5\x8a\x14!\xc2G\n\x87ښ\x1a\xad\x97I-\xf4\xf4\xb2B76\xe0厘m׀\xa0<\x80m,\xee\xda1\x14\xe0\x82 -dJ\a\x16\x83\x12\xb5?\x1a7=\xa6\x04\xa6#[9,I\xd1ցۘF\tJ\x1e;\xb4\x1e,r\xb3\xd6\xf2\x1f\x1deG\x90HG*\xe6\xd1\xf9\x13\x8a\x01\xec5S\xa4\xe6\x06\xef\x81i\x01\x15;\x80ŀ\x9c\x8d\xeeQ\vK\\\x0e_\x8cE\x90\xba4\x05l\xbc\xaf]1\x9f\xaf\xa5Oy\x90\x9b\xaaj\xb4\xf4\x87y\xc8fr\xd5xc\xdd\\\xe0\x0e\xd5\xdc\xc9\xf5\x8cY\xbe\x91\x1e\xb9o,\xceY-g\x81qMº\xbc\x12\xdft\xcep\xd7\xe3t\x10\xe3a\xac\x8d\x89\xb3z\xa7hhm\xdenkE<\xaaW\xeau0\xc4\xd7?._!\x1d\x1aL\xd0#\x99\x9c\xe0\xb8\xcd\x1d\x15O\x8a\x92\xbaD\x1bvAiM\x15(\xa2\x16\xb5\x91ڇ\x17\xae$\xeaS\xa5\xbbfUIO\x96\xfe{\x83Γ}rX\x84j\x00V\bMM`*r\xf8\xaca\xc1*T\v\xe6\xf0\xff\xaevҰ\x9b\x91J\xaf+\xbe_Ĥ\xbfva\xab\xadn8\xd5\x17\x93\x16\x9a\x8c\xd2e\x8d\xfc$N\x04:iɗ=\xf3HA\xc2b\xd0\xf6\xc8\xc2\x05`<\x1f\xbc\xf4\x1c\xb3\xd3\xe9\xf8\x80Շn\xd9\to\xf5\xd5\xfc5 \n\x1d\xfe\xe4\x83\x19\xd4M5da\x06_\x91\x89g\xad\x0e\x93\x13\x7f\xb12\xe4\\\x80+\xe6\xa2_\vm˃\xe6/h\xa5\x11\x17\xc5}\x1c,\xee\x84ޘ=\x94\xc1m\xb5W\a\xf0\x06\xdcA\xf3H|@\x11\xe0\xe1\xe5st\x88\x18\x1c\xa7\xf5X\x0e\x0f1&M\t\x1fAHG\x95\x91\v$\x87ꡲ\x96f\v\xf0\xb6\xb9Yhnt)\xd7CQ\xfb\xc5\xee\xb4W\\$:\xd0\xd5\"\x9cA@C\x15L*\x8dg\xe4\xf9\xb2\x94\x9c`\xb9\x94\xeb\xc6\x06\xabC\x19\x12\xe2P\xba\xc9ء\x1f\xb7((F\x99*.\xf2\xd0-\xa3\xe3<\x93\xba\xcd1\xc7\xed\x018l\x15\x13\xa1\xf6\xa8E,\xdf\xfa\x8f7\x01\x7f\x1c\n\xd8K\xbfia-y\xec`\xf5\xb9\x88\xa2g\x8b\x87\xf1\xe0\x80\xe7\xd7\r\xc2\x16\x0f\xa9Sp\xc8-\xfa\xe0Q\xa8(\xf5\x90\xc3\xe4\x00_\x1a\xe7\x89)F\xae\"\xc7,\xd3\x13\xf7n\xf10T\xec\x15CƲ\xec\x1a\xabwT\xaf$F-\x96hQ\xfbI@\xa6\x8e\xcdj\xf4\x18ZBa\xb8\xa3,ȱ\xf6nnvhw\x12\xf7\xf3\xbd\xb1[\xa9\xd73R\xf1,\xc6ǜ\x18q\xf3o\xc2?\x13\xfc\x00\xbc>\x7fz.\xe0A\b0~\x83\x96z\x9c\xb2Qɡz\x95\xc8}ȋ\xf7\xd0H\xf1\x87\xbblD\xe7\xb2>L\xb0\x0eSWuB8-\xcb\x03\x95Q\x81\x1dRͲ\xb5\x83\xb1@ٍ\x8c[E\xeb\xb5\xf81e\xbda\x15\xdc\xff#\xa0!\xec\x1f23#\xc7y\x7f\b\xa5\x9e\xb3\xc8.ȵ\x18-O͓\xee9A/\xb0R\xc4\xdf\x0f\xa8&\xf4i(y\x19\x1d\x9d\x9c\n\x87{\xf0\x1b\xe6\xfbAK\xe0\x81\x9eoPD\xb7\xff\\\x02V\xb5?\x8c\x89\xf6v\x05O\xa4\xe2\x01XR\xbdlωU\xadNev\x9e\xdd\xe8\x02\xb1Ź\xa8\xa1\xd4\xedH-$\xa7\x8a\xf2\x14&\x92\xba\"\xa9\xff4\x1f\x9e\xf7\x8b\x93ˀ\x8b\x9c>\xf7W\xa6\xa2\x00\"2\xc7\x14\xee\xd0{\xa9\xd7\x0e4R\x8agv\xe8X\x01\x15\xb9њ@)\xe8:Y\xfc\xce\r\x13\xda; \xb2\xbd\xc4\x18\x8fO\xdfiD\x9d\xae\xae\xdcp\f\x19\xb8hk\xfaq\xb6@{\x9d\x8b\xc5\x03-\xeb\xaa\x00\x06\x8b\aX5Z(L\xbc\xec7\xa8a\x87V\x96\a\xaa\xab_\x9f\x96\x134CH\x90\x1eC\xc1\x14\x9b\x92\xa4\xcd)\xde۔U\xc0\xea\xe0\xf1\xbd\xa2\xd5\x16K\xf9\xebU\xd1^²\xa4\xe0\x9a\xf9\rH\xed\xa4\xa0\x8c3V\xf7D噞d\x02x\x8e\x10\xfaNc\x9c\a\xbb\xde}\xd7\rx\x97\xf4Yd\x17\xa5>Ŷ\xb4)\xe1\xdbi\xd0\xe6ٍR\x1c{\xf5\xefI\x1c\xd4\xfcp\x91\x8d\xb7\xf1\xfa\v\xa5f\xa4>\xf6\x04\xe2\x98\x1bk\xd1\xd5F\v\xf2\xbf\xdb\n\xcd#\xbb\xff\x8brsʀ30}\f:\x99I:Ϯ\x185ކdgt8\xd9\xf9,ÞN\x97\xa4 \xb3\n\x173\xbdFjrgv\x1d\xben\xec\x99>\xf4\x9a&j\xc354:\x94\x96\xa1d\xc9\xe1\xaf\x1a>QSM9D\x14\x84\x05TN\x9d6\xdf\xf4h\xb3\xa7\xcd=j\x81@ʩT\x88\x84k\x8b\x90\x85ک\xbdT\x8a\x8aF\x8b\x95\xd9M\x94\x1d\x94@-\xaa\x03]Ϛ\x12v\xbf\xcb?\xe6\x1f~ㆌ\xeeb\xa9\xc3B\xf1\x15wrx\x854\xd6\xe6\xd3h}\n\xdeε\xe9\xe5\x97ԛ\xcfm\\\xf6ˀ,@)\x15\xa6R\xe14ҏY||]\xfc\xb8|\xbas\x84\xe0\x1e\xb5\x1f\x9biO\xd7iԺ\xa1\x00\xa9#\xb8s\xd58\x8fv\xc2؝\xad\xa4\x03m@\x19\xbd>\t\x85\xf6\x17\xafB\xc0\x84zW\x84&[ \xddbP\x94\xf3\r\xd3k<^oE\xde{\\\x92c\x8c9=\xf5\x8e\xa37H=\xed\n7ؐ\xae\x95/\xda\xefh\xbe\xf3\x17\xf2\x1d\xd7і\xc9\x18\xef\xd3u6\x9dCI\x913\x9f>\x18\xfcwP\a0\xfe\x0eqU\xfa\xd3\xe5\xd3\x1a\xe8y\xe3%\xf1Y\x87\xdd(~{\xd9\xc3砋\xe2\xbeЊ$!o,\xf5\x8bGܥ\xc1I\xec\xcdo\x82\xa0\xee{\xd2hf\xf8}\xe9\xaa,\x13\xf9f0\x14o\xa9\v\xd8}{|\x8b\x9f\x05\xa9\xbd\x88\x13ԃSr\xe9)2\"J\x1c9&1\xca\x1e\xb5G\xd1\xfb\xc0@\xfdj\x01\x1f>\x9c|\xa0\b\xaf\x9c\xf29\xf9\x80+য়\xe9c\x01y\x86\x88\x9d\xae+য়\xb3\x7f\x0f\x00\x93\x9ajO\x9f\x1d\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V;\x93۶\x13\xef\xf5)v\xfc/\xdc\xfcE\xd9\xe3&\xc3\xce9;3\xce\xe3\xa299n<. `E\"\a\x02\xc8.\xa0\xcb%\x93\xef\x9eY\x80\x94(\x9d\xe4K\x8a\x88j\xb0\xd8\xe7o\x1f\xd8\xc5r\xb9\\\xa8h?!\xb1\r\xbe\x05\x15-\xfe\x9e\xd0ˉ\x9b\xfbo\xb8\xb1a\xb5\x7f\xbdŤ^/\xee\xad7-\xdcdNa\xb8C\x0e\x994\xbeÝ\xf56\xd9\xe0\x17\x03&eTR\xed\x02@y\x1f\x92\x122\xcb\x11@\a\x9f(8\x87\xb4\xec\xd07\xf7y\x8b\xdbl\x9dA*\x16&\xfb\xfbW͛\xe6\xd5\x02@\x13\x16\xf1\x8fv@Nj\x88-\xf8\xec\xdc\x02\xc0\xab\x01[0\xe80\xe1V\xe9\xfb\x1c\t\x7f\xcbȉ\x9b=:\xa4\xd0ذ\xe0\x88Z\f+c\x8asʭ\xc9\xfa\x84t\x13\\\x1e\xaaSK\xf8~\xf3\xf3\xedZ\xa5\xbe\x85\x86\x93J\x99\x9b\xd8+\xc6\xe2\xb0A\xd6d\xa3\b\xb7\xf0\xaeX\x83o\x8b9\xb8\xab\xf6\xa0\xca\x00g݃b\xf8\xe0\xd7\x14:B\xe6՚\x82Ff4EU\xf5xS\xb8\v!=Fl\x81\x13Y\xdf=q$\xa2nj\\\xb7j\xb8\xe0\x8bP!\xec\xa0\xf2@\n\xb0\xc5\x11\x8e\xb9\xb9\xd1׃\x8e\xafٜ2\xd7<A}\xa6\xf0m7WdT\x92cG!\xc7\x16\x8e\xc0W\xf3c\xd2k\xc1T\xf0\xaa?#t\xe5\xd6YN?\\\xe3\xf8ю\\\xd1eR\xeer\xc2\v\x03[\xdfe\xa7\xe8\"\xcb\x02 \x122\xd2\x1e\x7f\xf1\xf7><\xf8\xef,:\xc3-\xec\x94+\x99f\x1d$\x1e\xc1\x89\xa3\xd2%e\x9c\xb74\xd67\xb7\xf0\xe7_\v\x80\xbdr֔\x8a\xac\xa1\x85\x88\xfe\xed\xfaç7\x1b\xdd\xe3Pj\xfeJ՜\x84\x05\x96A\xc1\xe8\x9c\xe4\xae\xfa\f\xc1#\x04\x82!\x10\x8e\x89\xe5fT\x19)D\xa4d'P囵\xec\x81vf\xfc\xa5xWy\xc0H\x93\"C\xea\x11\xf6\x95\x86\x06\xb8x.\xa5\x94z\xcb@X\x90\xf2\xb5mgjAX\x94\x87\xb0\xfd\x15uj`#h\x12\x03\xf7!;#\x9d\xbdGJ@\xa8C\xe7\xed\x1f\a\xcd,\xf1\x89I\xa7Ҕ\xf3\xe9Wz\xd1+'\xb8f\xfc?(o`P\x8f@(6 \xfb\x99\xb6\xc2\xc2\r\xfc$\xe0X\xbf\v-\xf4)EnW\xabΦiH\xe90\f\xd9\xdb\xf4\xb8*\xa3\xc6ns\n\xc4+\x83{t+\xb6\xddR\x91\xeemB\x9d2\xe1JE\xbb,\x8e{\t\x96\x9b\xc1\xfc\xef\x90\xf1\x973OϺ\xa6\xd0jY_\xc5]J\xba\xa6\xb9\x8a\xd5\x10\x8f\xf0ZߕDܽ\xdf|\x84\xc9hI\xc1L%\x8ch\x1f\xc5\xf8\b\xbc\x00e\xfd\x0e\xa9H\xc1\x8e\xc2P4\xa271X\x9f\xcaA;\x8b\xfe\x14t\xce\xdb\xc1&\x9e\xcaO\xf2\xd3\xc0M\x19\xd52Cr\x94\xa66\r|\xf0p\xa3\x06t7\x8a\xf1?\x87]\x10\xe6\xa5@\xfa<\xf0\xf3\x17f\xfaUƊց<\x8d\xff\x8b\x19\xbaЖ\x9b\x88Zr&\xc0\x89\xac\xddY]\xda\x00v\x81ࡷ\xba\x9f\xdar\xa6\x15\x8e\r<5뵆\x95\xef8\xd4O\xe9W\x82\x85\x92'KxRk˙\x9agQ(/ο¡HLH\xe8L\x84\xfe\xf0\xce\xc9\x14\xb8$\xf4ObG\xa2@g\xb43w\xde\x17\x16\x19'IYϠ\xfc\xe3(\x06\xa9W\t\x1e\x90\x10\xd0\xeb\x90ev\xa0\x01\x93\xcf\xf0\x1a\xa1\xe8\xc7\xe7P\xd2\x17\xebC<wQ>\x9bpx\xe2\xcd\xd5<\xc8_\x96\x0f\xb5u\xd8B\xa2\x8cg\x97UN\x11\xa9Ǔ\x9b\xb2M|5\xe8\xb5p\\\xc2\x1be\xe8\n\xf1\x19\xc0\xe5\x8f>\x0f\xe7V\x96p\x8b\x0fOh\xc7\x15\xe5\xc9\xd5\xe9\xca\xf2,&\x17\n\xee\x8c4>4-\xec_\x1fO\xe3\xe2%;\xc2x\x01P^g3\x03\x96S \xd5MP\x1f\xabXi\x8d1\xa1\xb9=\xdf0^\xbc8Y\x15\xcaQ\a_W?n\xe1\xf3\x17y\xd4S 4\xe3\x93\xc8-|\xfe\xb2\xf8{\x00\xb0\x1aq.\xff\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x13\xbe\xebW\f\xf2\x1e\xf2\x16\x88\xe4\x04\xb9\x14\xba\xa5\x9b\x14H\xb3\xdd.\xecM.A\x0e45\x96إH\x96C\xda\xd9\x16\xfd\xef\xc5P\x1f\xd6J\xf6z{\xa8\xe5\x8b\xc8\xe1\xccÙg>\x94\xe5y\x9e\t\xa7\xbe\xa0'eM\t\xc2)\xfc\x1e\xd0\xf0\x1b\x15\xf7?R\xa1\xecj\xfff\x8bA\xbc\xc9\ue569J\xb8\x8a\x14l\xbbF\xb2\xd1K|\x8f;eTP\xd6d-\x06Q\x89 \xca\f@\x18c\x83\xe0e\xe2W\x00iM\xf0Vk\xf4y\x8d\xa6\xb8\x8f[\xdcF\xa5+\xf4\xc9\xc2`\x7f\xff\xbax[\xbc\xce\x00\xa4\xc7t\xfcN\xb5HA\xb4\xae\x04\x13\xb5\xce\x00\x8ch\xb1\x84\xca\x1e\x8c\xb6\xa2\xf2\xf8GD\nT\xecQ\xa3\xb7\x85\xb2\x199\x94lTTU\x02&\xf4\xadW&\xa0\xbf\xb2:\xb6\x1d\xa0\x1c~\xd9\xfcvs+BSBAA\x84H\x85k\x04a\x02[!I\xaf\x1c\x1f.\xe1}oi\xddY\x82N\x1a(\xca\x06\x04\xc1\r\x1eV\xb7\xdeJ$\xc2*\x9d\xee\x00n\x92XZ\b\x0f\x0eK\xa0\xe0\x95\xa9\x17\xb6\x1d\xca\"\b_c(\xf8\xe0\xd2\xfe\x8dh\x11\xec\x0eB\x83 \x88\xacT\"`\x05\x9f\xe2\x16\xbd\xc1\x80\x04\xbe\x8f\xc5\xc4\xfa]\xd2\b7\x83\xc6\xe7B\xe0\x10/!\xdc=\xb8\x04a\xa74B\xb0\xa3\xf3\x97\x06?\r\xe7\x9f28\x10\xa5X\x04y\xa2\xf0]=E^\x89\xc0\xaf\xb5\xb7ѕp\x8cuG\x87\x9ec\f~\x11\xaf\xb4\xa3\x15\x85O\xa7v\xafU/\xe1t\xf4B/y\x956I\x99:j\xe1\x17\xdb\x19\x80\xf3H\xe8\xf7\xf8\xd9\xdc\x1b{0?+\xd4\x15\x95\xb0\x13:\x91\x89\xa4e\xfc\x1c\brB&\x8aP\xdc\x0e!\xa3\x12\xfe\xfa;\x03\xd8\v\xad\xaaD\xf8\xee*֡yw\xfb\xf1\xcbۍl\xb0M)\xb5\x88\xca\xec*\xa0\b\x04\xf4\xc0\xa6Q\x02a@\xf8\xa0vB\x06\xd8y\xdb\xc2V\xc8\xfb\xe8z\x9d\x00v\xfb;\xca\x00\x14\xac\x175\xbe\x1a\xa9-zAжN\xb1/\xfa#\xce[\x87>\xa8\xc1\xf1\xfcL\xaaȸ6\x03\xfc\x92o\xd4\xc9@\xc5u\x03)\xb1z߭a\x05\x94n\xcbT\v\x8dbb'\uf6ae\x92L\xd4\x02\x8b\b\xd3#/`\xc3\x11\xf0\x04\xd4ب+.6{\xf4\x01<J[\x1b\xf5稙\xd8/lR\x8b0pc\xf8\xa5\x12a\x84\xe6XD|\x05\xc2TЊ\a\xf0\x98\xbc\x13\xcdD[\x12\xa1\x02~\xb5\x1eA\x99\x9d-\xa1\t\xc1Q\xb9Z\xd5*\fuSڶ\x8dF\x85\x87U\xaa~j\x1b\x83\xf5\xb4\xaap\x8fzE\xaa΅\x97\x8d\n(C\xf4\xb8\x12N\xe5\t\xb8\xe1\xcbR\xd1V\xff\x1bY\xf2r\x82t\x96Yi\xad\xa3\xfeY\xbf3\xf5;ztǺ+\x1eݫL\x9d\x02\xb1\xfe\xb0\xb9\x1b\xabI\n\xc1D\xe5ȓ\xf1\x18\x1d\x1dώRf\x87>\x9d\xeaX\xc6\x1a\xd1T\xce*\x13\x92z\xa9\x15\x9a\xc7N\xa7\xb8mU\xa0\x81\xb6\x1c\x9f\x02\xaeR\xf7\x80-Bt\x9c\xf8U\x01\x1f\r\\\x89\x16\xf5\x95 \xfc\xcf\xdd\xce\x1e\xa6\x9c]z\xd9\xf1Ӧ7\xfc:\xc1\xce[\xe3\xf2ЕNFh\x96\xca\x1b\x87\x92\xe3\xc5N\xe3sj\xa7dJ\x01\xd8Y\x0f\xe2\x98ٽۆ\xbc<\x97\x9b\xfct=\xe6\xf1\xda\fE_\xc3\x15\xc1\xa1\x11\x8fK\xc8\xff\xb1\xa8\v\xae\x03\xd4C\xe8*\xc3\x0fS\xcbOY?\xc5ѓ\x18\x06\xaa\xf2\xd5Ù\xb637\xca\x0f\x9a؞R\x9e\xc3O\t鵭\xb3\xd9\xd6d\xf7ʚ\xc0\x84~B\xe4\v\x0f\x0f\xb81\xc2Qc\x9f\x94\x1cF\xa3\xb1\xb7\x9c\x15\x8b\xfa\x8c\xa25r1\xc6s\xa0\xfb\xedghx\xef\xd5.\xac\xd1Y\x7f\n\xcaIF\x0f\x0f7\u058b\xe1\xe2\xbe6\x84\xcbL\x06\x95\xfb\xe5t\x02\a\x15\x1a84J6'\xb4B\xaa\x10)Ҋ&sN\xf1\xef`sB(\x8f\v\x9e\xe50N6\xc7_\x0e\xe3\xc4u!yO+\xce\xfb\xa4\xca.\x9c\xee&\xc62;\xe3\xc3y\xf2'\xe9\xc1\xa92z\x8ff\x9c:\xb9\xed\xcdg\x98\"\xbb\x9c\x7fC\xea|^_\x97\xd9\x13\xf1\x1cT\x7f^_s\x17\rB\x99\x0e\x87\U000d84ea\rV\xc0{\\\x04xy\xe1\x80\xee?\x1d\x16.F\r\xbf;\xe5'\xb3\xcf\x19h\x1fF1\xf6͡A\xd3\xf5\x9a\x997:uH\xa9\x7fK\xf1xj\xe0g\x8bP\xa1F\x9e\xa1\xb7\x0f\xe9n\xf4@\x01\xdb9ޝ\xf5\xad\b\xdd\xe8\x99\a\xb5 \n\x7f\x8e\x88\xad\xc6\x12\x82\x8f\xf8\xdc˦\x8f\x8c'\xefy\xcb\x12\xa7\xc2?&\xd7\xec\xc6Ev\xb9\x14\xe6\xfc\x9d\xb2X{\xfc\xddr\x11\xfd\trϖ\xfaI\xae\x84\xfd\x9b\xe3[\xff\xc1Ź\xd6o\x00\xa4\x91\xb9\x9a\xb8\xae\x1f>\xfb\x95c\xc6\b)\xd1\x05\xacn\xe6c\xfe\x8b\x17\x8f\xe6\xf6\xf4*\xad\xe9>\xf9\xa8\x84\xaf\xdfx\xd2\xe6*Z\xf53'\x95\xf0\xf5[\xf6\xcf\x00\xa2#\x0e+\xf3\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yݏ\x1b\xb9\r\x7f\xf7_A\xec=l\x0f\x88Ǘ\\Q\x14\xf3\x96l\x9abۻd\x91\xdd\xcbK\x90\ayı՝\x91TQ\xe3\x8d{\xb8\xff\xbd\xa0>\xec\xf9Z\xafsA\xee\xd6\x06\x12\xeb\x83\xfc\x91\")\x92Z,\x97˅\xb0\xea\x03:RF\x97 \xac\xc2\xcf\x1e5\xff\xa2\xe2\xfe\xefT(\xb3\xda=_\xa3\x17\xcf\x17\xf7J\xcb\x12\xae:\xf2\xa6}\x8fd:W\xe1k\xac\x95V^\x19\xbdh\xd1\v)\xbc(\x17\x00Bk\xe3\x05\x0f\x13\xff\x04\xa8\x8c\xf6\xce4\r\xba\xe5\x06uq߭qݩF\xa2\v\x1c2\xff\xdd\x0fŏ\xc5\x0f\v\x80\xcaa\xd8~\xa7Z$/Z[\x82\xee\x9af\x01\xa0E\x8b%X#w\xa6\xe9Z\\\x8b꾳T\xec\xb0Ag\ne\x16d\xb1b\xa6B\xca\x00L47Ni\x8f\xee\x8a7D@K\xf8\xd7\xed\xbb\xb77\xc2oK(\xc8\v\xdfQa\xb7\x820\x80\x95H\x95S\x967\x97pc$|\xe0\x9d\b\xaf\x02/\x88끺j\v\x82\xe0->\xac\xae\xf5\x8d3\x1b\x87D\x81@\xc4x\x1bօ\x01\xbf\xb7X\x02y\xa7\xf4\xe6\x11\xf6\xe4\x85\xf3\aq\xa78x\n\x1e\xb6\xa8\xc1o\x15A\x94\x1b\x1e\x041\x1e\xe7Q\xf68_\xb1\xf6\xd2Hd-\x85\xc7\tc\x8bUa\x8d,\x18.YQ\xcdH\xff6O\x81\xa9\xc1o\x91\x15\x1f\x0eS(\xad\xf4&\fŃ\x00o`\x8d\x01\x17J\xe8l\x0f\u0381\xc8Ӻ\xe8C\x9aG\xf35@n\x8c<\x0fB\x14\xe94\x80'\xb9}8\x12y\x92\xa1Ck\xae%j\xafj\x85n\xca\xf8=\x92W\x15\xf02R\u07b8=\xa8\xc3j\xa8\x8d\xeb\x1bE\x0fB\xda\xf6\x1e\xad9\x0fG\xa4p\xeb\x8d\x13\x1b\xfc\xc9T\xc1\tO\xeb!yE\xda\x03y\x13۪Á\xb1\xd2\xd6t\x8d\xe4\xc3!o\xdc\xc0bǻ\x9fD\x9b\xa3M1\x89\x14=\xaa/78\xf5\x81\x8d3\x9d-\xe1\x180\xa2u\xa4@\x15\x83܍\x91\xf1\xf4^\x1d5\xda(\xf2\xff\x9e\x9b\xfdI\x91\x0f+l\xd39\xd1L\x83S\x98$\xa57]#\xdcdz\x01`\x1d\x12\xba\x1d\xfe\xa2\xef\xb5y\xd0o\x146\x92J\xa8E\x13\"\x12U\xc6\xf6݈\x15G\xddڥ\x18L%\xfc\xfa\xdb\x02`'\x1a%ÁEQ\x8cE\xfd\xf2\xe6\xfaÏ\xb7\xd5\x16\xdb\x10\x97y\xd8:c\xd1y\x95%\xe6O\xef\x0e8\x8c\x8d\x8e\xfc\x92I\xc55 9\xea#E\xa7\x8bc(\x81\x02\x9bh\x16\x8a\xd8VY,\xed\x8f\a\x9a?\xa6\x06\xa1\xc1\xac\xff\x83\x95/\xe0\x96Ew\x94ͣ2z\x87\u0383\xc3\xcal\xb4\xfa߁2\xb1\xaf1\xcbFx$?\xa0\x18\x02\xbc\x16\r+\xa1\xc3g \xb4\x84V\xec\xc1!\xf3\x80N\xf7\xa8\x85%T\xc0\xcf\xc6!(]\x9b\x12\xb6\xde[*W\xab\x8d\xf2\xf9֫L\xdbvZ\xf9\xfd\x8a\xa3\x8cS\xeb\xce\x1bG+\x89;lV\xa46K᪭\xf2X\xf9\xce\xe1JX\xb5\f\xc05\vKE+\xbf;\x1c\xcfe\x0f\xe9Ȥ\xc3X\xb4\xb9G\xf5\xce6\a\x8a@\xa4mQģzs\xf4{\xff\x8f\xdb;\xc8L\x83\xdf\xf5HB\xd2\xf6q\x1b\x1d\x15ϊR\xba\xc6\x14Ejg\xdap\xb4\xa8\xa55J\xfb\xf0\xa3j\x14\xea\xa1ҩ[\xb7\xca\xf3I\xff\xb7C\xf2|>\x05\\\x85\xbb\x9f\x9d\xbc\xb3\xecq\xb2\x80k\rW\xa2\xc5\xe6J\x10~s\xb5\xb3\x86i\xc9*}Z\xf1\xfd\x94%\xffŅQ[\x87\xe1\x9cS̞\xd0(\x1c\xdcZ\xac\xf8\xbcXi\xbcO\xd5*ED\x8e\xd3b\x1c=\x8a\x1e\xd99\xd7\xe4\xcflT\x1e.\x19az5\xb7#\xa3ҽ\xe8\x9dCs\x8c\xbf#\x92\x00Mޚ\xa39\x82\x9b^E\x94\x02z_\x96G\x95\xce_m$\x9e\xc4\xff\xd6H\x9c\x83\xcb\x1b\xc1oE\xb4I\xce\xcd8\xd2t:\xe4\x00F\x9f\r\xc0\x1ay\x92\x7f\xa2,\xc0a\x8d\x0e5{\x94y2\xef\x18Q\x84Af0\xc6\xf6\xd8a?\x1e\x8fg\x91\xbe\xbc\xb9\xce18+)a\xf6c\x8e'5\xc2ߚ/\x9ep\xc1>\xc5\xf5\U000ba3aaa:\xac\x1a\x01Va\x85\x83\xd0\x0eJ\x93G!\xe3\xe0\fI\x00v\\\x87i\xfd\xb3\x18\x7fR\x98;^\a^(\r\x82㞒!\aX\xfd\xd3D\xac\xb34EU!1\x19\xe1\xb1E\xed\x9f\x1dRu\x89\xa4\x1cJṈh\x85V5\x92/\x12\at\xf4\xf1ŧ9\x9d\x01\xbc1\x0e\xf0\xb3hm\x83\xcf@E-\x1f\x02j6\x106WVā\x1e<(\xbfU\xf3\x82\vN\x03\x92\xc0\x0fAP/\xee\x11L\x12\xb4Ch\xd4=\x96p\xc1!\xa4\a\xf1W\xf6\x86\xdf.fi\xfe%:\xe9\x05/\xb9\x88\xc0\x0ewf߉\x8e\x00\xa3'9\xb5\xd9`\xce\xc7\xc6\x7f\xbc\x01w\xa8\xfd\xf7`\x1cˮM\x8f@ \xab(\a:\x94\x13\xc0\x1f_|z\x04\xed\x91\n\xeb\t\x94\x96\xf8\x19^\x80J\x15\x8e5\xf2\xfb\x02\xee\x82E\xec\xb5\x17\x9f9\x1eT[C\xa8\xc1\xe8f?\x8f\xd6\xc0V\xec\x10\xc8p\xb5\x84M\xb3\x8c\xb9\x8a\x84\a\xb1g\xf9\xf3q\xb1\xd9\n\xb0\xc2\xf9a62K\xf5\xee\xdd\xebweD\xc5&\xb4\xd1\f\x85o\xb9Zq\xce\xc1\xc9F\x98\f6\xc9s\xd4\x05j\f\xa7\xda\n=\x13X\xf9\x1b$E\xa8;N!\x8a\xcb\xc5d\xc1io\x1d\xa7\r\xf3\x8e\x1a҇q`\xf8\x93.\xe1\xb3\xc4b\x93zZ\xac~\x05rR,n58\x8d\x1e\x83d\xd2T\xc4BUh=\xad\xcc\x0e\xddN\xe1\xc3\xea\xc1\xb8{\xa57K6\xc4etlZ1\x10Z}\x17\xfe\xf9]R\x84d\xfd<Q\x065\xf6\xb7\x94\x87\xf9\xd0\xea\x8b\xc5\xc9y幷\xd2\xe5m\xca|\xc6;\xd9%\x1e\xb6\xaa\xda\xe6\"\xe1\x18=gh\x02\xb4BƐ+\xf4\xfe\x9b\x9b-+\xb2s\x8cg\xbfL\x1d\xab\xa5В\xffO\x8a<\x8f\x7f\xb1\xe6:u\x86\x93\xfer\xfd\xfa\x8f1\xe6N}\xb1G\xce&\xc4\xfc\x1d\xf6,\xca\xc5\t\x01\xdf\x0f\x96\xe6\xc4n&\x93<\xac)\x16g\x02\xf4b3I\xa0\xfa\xad\xbfǓ\xac\x132\x0f\xc0߉\r\x81p\b\x02Za\xf9\x9c\xeeq\xbf\x8c\x97\xb4\x15ʱ0\xc2\xe7\xf2u\x8d \xacm\xd4\xccu\xeaM?]L\x99\xb7\xa0 Bq\xae\xd6c۩<\x058\xb5+g\xd2\xe7Ě-#]>\x9c\xe8\xf6[X#\xba0\x93\xb8>\xa27\xae\x029\xbb\xeaC[\xc2z\xae\x10\x19\xac\xe0\x94~0`\x8d\x1c\xfc\x9e\xe9\x8d\xe5\xa9^\x9f\xee\x84\xda8\x13\xec\x06\x06p\xb2~\v\xab\xb3\x8d\xc6x\xe0s\xd3\xd7Կ\xaf\x82\xab\f\xe7\x8eÎ\xf6\xa9#\xbc\x9a\xae\x0f\r\x11'#,\xcf\xdd`\x91m\x88\xbb\xc0\x89ô\b\x83\x1e\xb1\xb8\x8fK\xa6@\veH\xed8묅jP&\x82T\x8c\xf7Lh\xf6i\xac\xb1\xe6t\xa2\xb3\x8d\x112\x17E\tZn\xf2\xdcq5\x1c\xfa\r\x97\xf4(ŎP\x86n\xe6\x8c\xf8\xe3\xeb\xa16\xae\x15>v\xf5\x963\x04\xf9\xb9@\xac\x1b,\xc1\xbb\x0e\xcf3a\x80\x16\x89\xc4\xe6\xb4{\xfd\x1cװ\x85\x88\xbc\x01\xc4\xdat\xfeP \x0e\\\xfc\x92\x92\xf5\x14碰3%\xd8\x00\x02\xd7h\xd9B\xeb\xaei\u008eTn\x1cR\xfc\xf8\xde\xc2u\x06\xac\x91\x8f\xe5k=\x1c \xbc\x91\x9cF\xc6+\xe6\x9c\xe7\x10\x83Nx\x0f\x7fQw\xed\x98Ò\x1fY&c\xa3G\x97\xe3g\x99\xadw\"\xec\x12\xde\x04;?[\xde\xc4\xe0\xb4\xc8i\x11lM\x93\xdd\xd3xр\xee\xda5:\x96{\xbd\xf7H\xc3 <\xa2\b\xa9\x8a8*\xad\xb7;\xb7\x10\"\x9dT\x14UBs\xd8\x0e>\xe3\rHE\xb6\x11Ӫ\xc8ft\x9c\xed\xb3˰K\x1f\xad5\xbb\xa9E\x17\xa6\xbe\xa4K\x11м6z\xe2.}\xffT\xda\xff\xed\xaf3\xf3\xd1\xf8\xb9o\xbb\x19\x04\xf54\xcb\n|\xb5\xf7sl\xbf\x8e\xf6\xa3\x17+iaik\xfc\xf5듧}{X\x96\xad|\xf2\x12\x83\aZ\xf9ȇWZ\xff\"/\xce5\xc5\xe1\xfb\xe0i\x88\x83\xa5O\xdc\x1b\xe9\xf5\x90\xbb\xc1V\xb8\xf8L8\xfc\v\xfd\xe0\xab\xf13\xcb3 \xc5y{\xc8}b2\x14K]\xe2\xeb\x84S;㢭N)\x0e.\x82A\xe0\x1fB\xff#b\xfe\x8c=\x8c\x86Rw\xad\x84\xdd\xf3\xe3\xaf\xf4\x8c\xcc\xc5a\x9aHb\xc9\x1e\xf3\xd4UM#\xc74\x84;T֣|;~w\xba\xb8\x18<$\x85\x9f\x95\xd11\x9b\xa5\x12>~⧟\xf0x\x96\xea)*\xe1\xe3\xa7\xc5\xff\a\x00-\xbc\x85&\xc9\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x8f۸\x11\x7f\xf7\xa7\x18\xec=l\x0f\x88\xe5K\xae(\n\xbd\xe5v\x9bbۻd\x11\xef\xe5%\xc8\x03-\x8e,v%R\xe5Pv\xdc\xc3}\xf7bH\xea\xafe\xafw\xd1\\m\x03\x89H\xce\xcco\x86\xf3O\xb3\x8b\xe5r\xb9\x10\xb5\xfa\x84\x96\x94\xd1)\x88Z\xe1W\x87\x9a\x9f(y\xfc+%ʬv\xaf7\xe8\xc4\xebţ\xd22\x85\x9b\x86\x9c\xa9>\"\x99\xc6fx\x8b\xb9\xd2\xca)\xa3\x17\x15:!\x85\x13\xe9\x02@hm\x9c\xe0e\xe2G\x80\xcchgMY\xa2]nQ'\x8f\xcd\x067\x8d*%Z/\xa1\x95\xbf\xfb!\xf91\xf9a\x01\x90Y\xf4\xe4\x0f\xaaBr\xa2\xaaS\xd0MY.\x00\xb4\xa80\x85\xdaȝ)\x9b\n-\x923\x16)\xd9a\x89\xd6$\xca,\xa8ƌ\xa5\n)=2Q\xde[\xa5\x1d\xda\x1b\xa6\b\x88\x96\xf0\x8f\xf5\x87\xf7\xf7\xc2\x15)$\xe4\x84k(\xa9\vA\xe8\xd1J\xa4̪\x9a\x89S\xf8\x18$@8\x05\xd4d\x05\b\x82;}o\xcd\xd6\"\xd1\xea\xc6Tu\x89\x0e\xa5'\x0e\x00\xd7\xfe\xb4_p\x87\x1aS g\x95\xde\x1e\x89\xae1Kj#\x13\xa6\xa2Zd3\x00\u07b7[`rV\xdc\x1bS(\xad\xf4\x16\\\x81\x10\f\x01\xce\xc0\x06!\xdac\b\xa5\xa3\x7f\x1e\x9ay /\xc4po\xe4e҃2\xa7e\xf7\x82\xa2\x94\x81\x90O=\xedY9\xf1\xba\xe3\xf5%\x9b\x83C\xba5zNjSmв\\\x7fhN\xb3\x9f\xfcFG\xfe\x1c\xc1\xce8Qz\xfac\xc9\x0f\xbc\az\"\xff\xa4}\xc3\xf1\x9e\xd7\x050\xc8\t\xeb\xba\xf8\x9aA\xa0*\x84}\x81\xda_p\x94\t\xa6F\xeb#\x13\xf6\x828&\xec\x91\xdfw+\x01\x84\x14\x0eO@\xc8B\xe0\f\xe3\xfce8\"\xa3\x11\x92qT\x9e\xc6\xd2\xe6\xad\xe4(\xe7\f\x98\xbd\xdd\xe21\x9b\xad5M\x9dB\x9fy\x82\x11b\xca\v\xe9\xf2\xde\xc8\xe0\x961\x8d\xf8\xadR\x91\xfb\xe7\xec\xf6ϊ\x9c?R\x97\x8d\x15\xe5L\xa2\xf3\xbb\xa4\xf4\xb6)\x85=\xde_\x00\xd4\x16\t\xed\x0e\x7fՏ\xda\xec\xf5;\x85\xa5\xa4\x14rQ\xfa\xf4F\x99\xa9\x87Y\x81\xedC\xcd\xc6ƌN)\xfc\xf6\xfb\x02`'J%\xbd\x85\x83:\xa6F\xfd\xf6\xfe\xeeӏ\xeb\xac\xc0\xcagy^\xae-߄S\xad\xd6\xfc\x1dT\x94nmr\xa9\xd7\xcc*\x9c\x01\xc95\x84\x9d\x9b\xf3HXC\t\xe4\xc5p\xe8\xb9B\x11X\xf4j\xe9PU\x06l\x81\x8f\b\rf\xf3/\xcc\\\x02kV\xdd\x12Pa\x9a\xd2\xe7\xa9\x1dZ\a\x163\xb3\xd5\xea?\x1dg\xe2\x1c\xc2\x1eU\n\x87\xe4F\x1c}\xb5Тd#4\xf8\n\x84\x96P\x89\x03Xd\x19\xd0\xe8\x017\x7f\x84\x12\xf8\x85\xbdR\xe9ܤP8WS\xbaZm\x95kkhf\xaa\xaa\xd1\xca\x1dV\x9c8\xad\xda4\xceXZI\xdca\xb9\"\xb5]\n\x9b\x15\xcaa\xe6\x1a\x8b+Q\xab\xa5\a\xaeYYJ*\xf9]w=\xd7\x03\xa4\x93(\xf7k\xc1\xefNڝ\xdd\x0e\x14\x81\x88dA\xc5\u07bcmB\xff\xf8\xb7\xf5\x03\xb4B\xfd\x15\fXB\xb4vOF\xbd\xe1\xd9PJ\xe7h=\x15\xe4\xd6T\xfejQ\xcb\xda(\xed\xfcCV*\xd4c\xa3S\xb3\xa9\x94\xe3\x9b\xfew\x83\xe4\xf8~\x12\xb8\xf1\x9d\x04'\xbd\xa6樓\t\xdci\xb8\x11\x15\x967\x82\U0001b6dd-LK6\xe9ӆ\x1f6@\xed'\x1c\f\xd6\xea\x96\xdb\x06e\xf6\x86\xa6\x19a]c\xc6\x17\xc6VcB\x95\xab\xcc\xc7\x00\xe4Ƃ8\xca ɀ\xf1\\p\xf2w#\xb2Ǧ^;c\xc5\x16\x7f6\xd9 \xccO\xa0\xfai\x8e\xa2\x85\xa5cm\xe6\xff\a\xd6\xc0PD̙\xc3oْ\xee\v\xb4\xd8et\x95\xb1+\x19R\xce\xd8\x03\xb3ez\x94C]N\x9a\x9d\x7f\xb5\x91g\xe1ߛ\xe8\xf4\x16s\xb4\xa83l\xa3\xff\\/3\xe1\b\xc3\xda;\x85v\xcaԧ\xf3\xe1,з\xf7wm\x0el-\x1a!\xbb\xa9ĳ\x06\xe1_Ή\xdf\x17ܧ\xa4^\xdf\xe5A\f\xf3a\xcb\b\xa8\x15f8J\xad\xa049\x142,ΰ\x04\xe0\xc0\xb1\x18Ͽ\n\xf1\x1f\xd3L\x9f\x8e\x9dP\x1a\x04\xe7\x1d%}O\xb0\xfa\xbb\tXgy\x8a,Cb6\xc2a\x85ڽ\xea:p\x89\xa4,J\xee\xb41\xa9\x84V9\x92K\xa2\x04\xb4\xf4\xf9͗9\x9b\x01\xbc3\x16\xf0\xab\xe0\xf6\xe0\x15\xa8`\xe5.\xa1\xb5\xfe\xc1\xbe͆\xe8\xf8\xc1^\xb9B\xcd+.\xb8\x0eG\x85\xf7^Q'\x1e\x11LT\xb4A(\xd5#\xa6p\xc5\x11<\x80\xf8\x1b\x87\xce\xefW\xb3<\xff\x14B䊏\\\x05`]\xcd\x1aF\\\x0f\xd0\x15\u0081\xb3j\xbbŶ;\x9c~\x98\x00w\xa8\xdd\xf7`,\xeb\xaè\x81g\xcb\xd1\x17\xf2\f\xca#\xc0\x9f\xdf|9\x81\xb6\xe7\xc2v\x02\xa5%~\x857\xa0\xb8mS\xc4\xf6\xf9>\x81\a\xef\x11\a\xed\xc4W\x8eǬ0\x84\x1a\x8c.\x0f\xf3h\r\x14b\x87@\x86[@,\xcbe\xe8\x15$\xecŁ\xf5o\xaf\x8b\xddV@-\xac\x1bw\x03\xb3\\\x1f>\xdc~H\x03*v\xa1\xadf(\\er\xc55\x9f\x8b\xbd\xdf\xf4>\xc9{\xd4xn\f'+\x84\x9eIk\xfc\xf3\x9a\"\xe4\r\x97\xf0\xe4zqt\xe0|\xb4N\xcb\xf6|\xa0\xfa\xf2=M\f\xff\xa7\"x\x91Z\xecRO\xabվ\xdd=\xa9\x16\x0f\x0e\xacF\x87^3i2b\xa52\xac\x1d\xad\xcc\x0e\xedN\xe1~\xb57\xf6Q\xe9\xed\x92\x1dq\x19\x02\x9bV\f\x84V\xdf\xf9\x7f^\xa4\x85o\x96/S\xa5{[\xff\xd6\xfa\xb0\x1cZ=[\x9d\xb6\xaf\xbb\xb4*]\xafc\xe31\xa5\xe4\x90\xd8\x17*+\xda&\xbdϞ3<\x01*!C\xca\x15\xfa\xf0\xcdݖ\r\xd9X\xc6sX\xc6\xf9\xd3Rh\xc9\xff'E\x8eןm\xb9F]\x10\xa4\xbf\xde\xdd\xfe1\xceܨgG\xe4lC\xca?\xee\xbf\xee$\x9b/Wh\xd3\xc5\x19\x05?\x8e\x8e\xb6]\xe0L\x1fםI\x16\x17\x02$-j*\x8c\xbb\xbb=\x8b`\xdd\x1dk\xa5\xf7&\x8f\xed[\xcbi2\x93\xba\x18I`s\x16E\xe8\xbb\xe7\xba\xe0\x88\x81\xef,\x96\x05\xee@_\x84\x84_\x87\xb8\xcd\x19\"Y\xcew\xf0\xa3\x13\xb5\x91\xa3\xe7\xf1\xfd\x8e\xb6z\xa3\x8f\x96\a\xa3\xb83\xbeÍY3jzϿ\xce\xf8\xe3\xad\xcdB|\xbav\xb6j\xf2\x17\xbe\xd0\xcc\f\x92\xce\xde\xdc\xcd\xf1y?!\xb02\xe0r<\xfb\x12\xedU\x8dGL\xd3{\x83\x01\xb7@\xe8\xc7\x15\x99\xb1\x12\xa5o\xb6\xb8\x0f̅*Q\xb6\x1c\x89[!\x04?\xa6\xb1\xd7ǹ\xb2e\xd3\x10J\xff\x9e7\x03xJ\x95\x1b[\t\x17\x86SKf0\xd9\xe7\xf9\xb9ؔ\x98\x82\xb3\r^\xe6|\x00\x15\x12\x89\xed\xf98\xf8%\x9ca\xc0\xa2%\x00\xb11\x8d\xeb^\xb1b@D\xf5\xaf)\xdexr)\f?\x9b?\v\xe2\x9eO\xcc\xf9U\x17\x94\xe7\x1c\x8b\xbf\xa8\x9bj*b\t\xefq\x7f\xb4\xd6\xcf\xfe\x8f\xb6\xc6S\xc7\xfe\xb3\x84w\xde\x03.V8\n8\xafs<\x04\x85)[ϝ\x9d\x1bG\v\xb4\x81>\xe1\t\xb1\xe7\xed\xed\xd6\xd3\xc7\x1b\x93\x81Q\xec\xe03\xa19\x93y\xeft\x06\xa4\xa2\xba\x14\xc7-|;\xe8\xf6e\x8f\x9d\x93_\xfc{\xbf8\x1a\xe5>睺\x1b\xda\x1fo\xf5\xa1\xa0\xb4\xfb˟g\xf6\x83\x9b\xf1\x94o;J\x85q\xb7\x1b\xcb\xff\xafy\x9f,\xbe\xe3Y\xfc\xd9;_\x8f\x8e>\x95\xb5\xe2\x80~jY\x18\xa5\x9f\xe3t3\x16\xf2Gd\x9a\x19\xd3L\x96\xe2X$\x85\xdd\xeb\xfe)\xfe1\x8fko܀\xa0\x96\x1c\b\x8fè\xb8\xd2\x17,\x1e-\xd4\x0e\xe5\xfb\xe9\xd0\xfe\xeaj4\x83\xf7\x8f\x99\xd1\xe1ω\x94\xc2\xe7/<3\xe7\x1c\"c#L)|\xfe\xb2\xf8\xef\x00\a\x89\x1b\xd1P\x1d\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMs\xdbF\x0f\xbe\xebW`\xf2\x1eryE%\x93K\x87\xb7\xd6mf\xd2ڮ\xc7Js\xc9\xe4\x00-Ai\xeb\xe5.\xbb\xc0\xcaU;\xfd\xef\x1d,I\x8b\xa2)œ\x99J>\x98X,\xf0\xe0\xc1\a\xa1\xc5r\xb9\\`k?Qd\x1b|\t\xd8Z\xfaS\xc8\xeb\x13\x17\x0f\xdfqa\xc3j\xffvC\x82o\x17\x0f\xd6W%\\%\x96\xd0\xdc\x13\x87\x14\r\xfdH\xb5\xf5Vl\xf0\x8b\x86\x04+\x14,\x17\x00\xe8}\x10T1\xeb#\x80\t^bp\x8e\xe2rK\xbexH\x1b\xda$\xeb*\x8a\xd9\xc3\xe0\x7f\xff\xa6xW\xbcY\x00\x98H\xf9\xfaG\xdb\x10\v6m\t>9\xb7\x00\xf0\xd8P\t\x91X\xac\x89\xd4\x06\xb6\x12\xa2%.\xf6\xe4(\x86\u0086\x05\xb7d\xd4-VU\x86\x86\xee.Z/\x14\xaf\x82KM\ai\t?\xaf\x7f\xbd\xbdCٕP\xb0\xa0$.\xda\x1d2e\xb8\x15\xb1\x89\xb6\xd5\xcb%\xdcg_p?8;@\xa7\x0f\x9c\xcc\x0e\x90\xe1\x96\x1eW\xf7\x84\xd5!\xdf\xed\x00\xae\xb3J\x16ȡ\xa5\x12X\xa2\xf5\xdb3\x9e\x1d\xb2ܠb\xf4\xe8\ri\xd4\xcfq\\#\v\x88m\b\x9a\xa3*<\"CL~\xe4:덬\x8d@T(\x1a\xe06\x86Ԗpd\xac\xa3\xb5\xcfU\x97\xe7.\xeac\xd0\xf9\xc8Y\x96_f\x8f\xaf-KVi]\x8a\xe8\xe62\x94\x8f\xd9\xfamr\x18\x9f)(ym$\xa6\xb8\xa7\xdf\xfc\x83\x0f\x8f\xfe\xbd%Wq\t5\xba\x9c\x176A\x99\xbcņ\xb8EC\x95\xca\xd2&\xf6\xb5\xc8%\xfc\xfd\xcf\x02`\x8f\xceV\xb9z\xbaxBK\xfe\xfb\xbb\x0f\x9fޭ͎\x9a\\\x9f*nch)\x8a\x1d\xc2\xd6\xef\xa8\x17\x9ed\x93,\xbcVS\x9d\x0eTZ\xfd\xc4 ;\x82}'\xa3\n8\xbb\x81P\x83\xec,C\xa4\x1c\x96\xef\xfaad\x16T\x05=\x84\xcd\xefd\xa4\x80\xb5\x86\x1e\x19x\x17\x92\xab\xb4e\xf6\x14\x05\"\x99\xb0\xf5\xf6\xaf'\xcb\f\x12\xb2K\x87B,'\x165\xe9ѣS\x12\x12\xfd\x1f\xd0W\xd0\xe0\x01\"\xa9\x0fH~d-\xabp\x017!\x12X_\x87\x12v\"-\x97\xab\xd5\xd6\xca\xd0\xfd&4M\xf2V\x0e\xab\xdc\xc3v\x93$D^U\xb4'\xb7b\xbb]b4;+d$EZak\x97\x19\xb8\xd7`\xb9h\xaa\xff=\xa5\xe7\xf5\b\xe9\xa4'\xb2\xac+\xbc\xb3\xbck݁e\xc0\xfeZ\x17\xe2\x91^\x15)+\xf7?\xad?\xc2\xe04\xa7`d\x12z\xb6\x8f\xd7\xf8H\xbc\x12e}M1߂:\x86&\xf3L\xbej\x83\xf5\x92\x1f\x8c\xb3\xe4OI\xe7\xb4i\xach\xa6\xffHĢ\xf9)\xe0*\xcf@\xd8\x10\xa4Vۮ*\xe0\x83\x87+l\xc8]!\xd3\x7fN\xbb2\xccK\xa5\xf4\xebďG\xf7\xf0\xe9\x14;\xb6\x9e\xc4\xc3d\x9d\xcd\xd0t$\xac[2\x9a0eM/\xdaښ\xdc\x03P\x87\b\xf8l\x84\x14#\xc3sͩ\xdf\r\x9a\x87Ԯ%D\xdc\xd2u0\xa36?\x83ꇹ\x1b\x03,\x9dzڅ\xfa\xff\xac\xe2\xc42\x80\xecPF\x1d*h\xfdS\x9b\xcf\xc4q\x96r\xfd\x1b\x8d\xf1\xf7\xb9v\xbc9\\\x8c\xe5f悆\xb2\v\x8f\x10j!\x7f\xf2f\xe8Qnhb\x12\xf4}\xf1b\x90ݘ\xfePiiՖ\xe2E\x80\xf7\x13\xe5\x81\xe7:9\xd7\x0f\xfc\xa5\tM\x8bb7\x8ezwZ\x0e\x13\xa3\x00\xb6sx\xd0\xf3o\xe5w\xaf\xaf{zz]\\D\xfe\xe9Tw\\ \xf9\xf2\x00B\xe3\x1ba\x99\x98\x84\xa1&\x18\xdaP\xf5\x00\xfa\xa2e\x8d\xf3\x85\xd85\xb96\xd2\xc94\\\xce\x17\xff\x89\xc6\\E\x9d(L\xb3yr8\xe1\xeb\xab\xc3 \xaf8\xe5\xe2\f\xa3\xcf\xc6AV\x1f\x885)F\xf22\xacR\xa1\xfeƁ0\xb39]\xcc\xf3\xf5s\xfd\x01\x92\xbb\xb4_M\x13W\x87ؠ\x94\xa0\xa3})þv\xfc궊\x1bG%HL\xf4\xb2\xac\x034Č\xdb\xcb\x11\xdct:\x8a\x1a\x87\v\x80\x9b\x90\xe4\f\xb1*\xbdD\xedEDy'\xbe\x88\xe7N5\xe6\xd2J/uN>5S\x17Kݪ\x9fɎ[\xf6\xf1\xb3\x84\xdb s\agb\x9a\xa9剨_\xe5Jؿ=>\xf5\xbf\x1at \xf4\a\x00yY\xadF)\xe6\xae7{ɱA\xd0\x18j\x85\xaa\xdb\xe9\x96\xfd\xea\xd5\xc9Ҝ\x1fM\xf0\xdd\xef\x16.\xe1\xf3\x17\xddq%D\xaa\xfa\xa5\x93K\xf8\xfce\xf1\xef\x00\"c\x04\x9d\xba\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfbs#\xb7\xd1\xe0\xef\xfc+PkWq\xf7\"R\xdes%u\xa7J\x9dK\xd1ʱ\xce^-k\xa5\xac+\xe5\xf8s\xc0\x99&\x89OC`\f`(1q\xfe\xf7\xaf\x1a\x8fy\x88\xaf\x01\x86Z\xed&\xc3Q\xd9+j\xa6\xa7\xd1/4\xba\x1b\r\x9a\xb3\x0f \x15\x13\xfc\x8cМ\xc1\x83\x06\x8e\xbf\xa9\xf1\xdd\xffQc&NW\xaf\xa7\xa0\xe9\xeb\xc1\x1d\xe3\xe9\x19\xb9(\x94\x16\xcb\xf7\xa0D!\x13x\x033ƙf\x82\x0f\x96\xa0iJ5=\x1b\x10B9\x17\x9a\xe2\xd7\n\x7f%$\x11\\K\x91e Gs\xe0\xe3\xbbb\nӂe)H\xf3\x06\xff\xfe\xd5W\xe3\xaf\xc7_\r\bI$\x98\xc7o\xd9\x12\x94\xa6\xcb\xfc\x8c\xf0\"\xcb\x06\x84p\xba\x843\"Ai!A\x8dW\x90\x81\x14c&\x06*\x87\x04_F\xd3\xd4 D\xb3\x89d\\\x83\xbc\x10Y\xb1\xb4\x88\x8c\xc8\xff\xbfyw=\xa1zqF\xc6\xf8\xc0xJ\x93\xbb\"\xbf\xa6K0x\xa6\xa0\x12\xc9r|\xfe\x8c\xe0\xb7D̈\xbd\x87h\xe1_KfR,\xcd\xfd\x16\x9b?\x99\x1b\xcc\x17z\x9d\xc3\x19QZ2>\xdfx\xa1\xa6\xbaP\xe3|AՖ\xb7\xbdw\xb0\xed]D\x15ɂPE\xae\xf8D\x8a\xb9\x04\xa5N/\xc42\xcf@CZ{\xf5\x8d\xb9\xbb\xed\xab\x95\xa6R\x974\xdd\xc4\x01\xffD\xee\x17\xc0\x89^@9Z\x91\x834\xdc \xf7T\x11\x03\xe31\x0e\xe57v\xfc)հ\x03\x85\xc4\x0e\xa2\xce\xdb8<\x1c\xa0\x06&M\n\x1d\xc4\x05\xa4\x14Rm\xbe\xfeB\x14\\#\xe7i\x96\x11{\x13\x99\x03ǷCJ\xd2\x02\x99[Ǭ\x86\xc1e\x05Ҿ\x1eEp\x0er\a\x06\xf7Tr\xc6\xe7\x87p\xf0\xb7\xb5\xc5\xe2\xc7:ؽxx\xad\x1doh\\\r\xdc\xf9\x1c6\t:\x97\xa2\xc8\xcfH\xa5\x80\xf6\xe5N᭱p2m\xbeɘ\xd2\xdf\u05ff\xfd\x81)m\xfe\x92g\x85\xa4Y\xa5\xd4\xe6K\xc5\xf8\xbcȨ,\xbf\x1e\x10\x92KP W\xf0\x17~\xc7\xc5=\xff\x96A\x96\xaa32\xa3\x99Q(\x95\b\xc4\x0f\xd5V\xe541\x92\xa1\x8a\xa9t\xb6J\x9d\x91\x7f\xfek@Ȋf,5rdQ\x159\xf0\xf3\xc9Շ\xafo\x92\x05,\x8d\xfd\xda\xe0\x86C\x990E(\xf9`\x86L<\\\xa2\x17T\x13\t\x06;\xae\x95\x91\f\x9a\xe7\x19K\xcc[\x88\x989\x90\xa4|F\x19\x13R\xc1\xaaL\f%\x9a\xca9h\xf2}1\x05\xc9A\x83\"IV(\rr\xec\xc0\xe4\x125A3Ok\xbcjV\xbc\xfc\xee\xd1\x18\x868H{\x0fI\xd1n\x83Eue\xbf\x83\x94(C\x00\x14:\xbd`\xaa\x1a\x92\x19F\r,\xc1[('b\xfaߐ\xe81\xb9A\xa6HE\xd4B\x14Y\x8a\xc6~\x05\x12I\x92\x889g\xff(!+\x1c \xbe2\xa3\x1a\x94n@D\xf9\x94\x9cfȞ\x02N\b\xe5)Y\xd25\x91\x80\xef \x05\xafA3\xb7\xa81ykX\xc2g\xe2\x8c,\xb4\xce\xd5\xd9\xe9\xe9\x9ci?o%b\xb9,8\xd3\xebS3\xfb\xb0i\xa1\x85T\xa7)\xac ;Ul>\xa22Y0\r\x89.$\x9cҜ\x8d\f\xe2\x1c\a\xab\xc6\xcb\xf4\x8b\x92Y\xc3\x1a\xa6\x8f\xac\xac\xf9\xceJ\xfbN\xba\xa3\xd4[ɱ\x8f\xd9!V\xe4\xf5z\xfc\xfe\xf2\xe6\xb6.UL\xd5@\x12G\xed\xea1U\x11\x1e\t\xc5\xf8\f\xa4y\xca\xca\x16B\x04\x9e\xe6\x82qm\xf8\x9cd\fx\x93誘.\x99FN\xffZ\x80B\xd1\x15crafo2\x05R\xe4\xa8\xeb\xe9\x98\\qrA\x97\x90]P\x05ONv\xa4\xb0\x1a!I\x0f\x13\xbe\xeet\xf8\x8f\xbd\xd1R\xab\xfc\xda{\a[9\xe4\xb4\xfb&\x87\xa4\xa1\x19\xf8\x10\x9by5\x9e\t\xd9P~\xb4a^%w\xa9%^\x95\x8b\xd1\xfc\xfe\x11\x12\x7f*oCYA\x86\x15\x9c\xfdZ\x80\xb1\xaa\xa8p\xf8Ն\xb9\xa8\x8cc\xf3\x83\"PGn'\x05\xf1\a\x1e\x92\xacH!--\xa7ڋ\xe9\xe5\xc6\xed\xa8\xf2\x9a2\x8e2\x8ev\x1e\xd1\xe5\xd5_\x8d\x81\xa4[\xb0D9c\xdcB#\xac1\xdb?F\x9eiXn\xa0\xb5gL\xc48\x8ct\x9a\xc1\x19Ѳx\xfcn\xfb\x1c\x95\x92\xae\xb7\x92\xc2;\xb8\xed(Q\xde\xed\xd4<c\t \rJe6\xc4\xf8\x9c\xe8\xb0\x10\xe2n\xffؿ\xc3;*kD\x12\xb30 SX\xd0\x15\x13\xd2q\xddM\tS \xf0\x00I\xe1]\xb3\xfa\xc7y2B\x92\\(\xbdkܻ\xb4\xab1\xabn\xfei'\xc1v\x19\x01\xcfJ\x1c^\xc3 \b\x0eDH\xb2\xc49\xa7\xbaW\x8a\xc2ޫ\x06[^@\xc8.*\x90)U\x90\x12\xe1x]d\xa0ܛRch*\xed9\xd9\x01\xb8\x1c\xb4\x9d+3:\x85\x8c(\xc8 \xd1B>\xa6\xdea\x1a\xb6\xb5\x04;\xa8\xb7\xc5&8\xeb\xe9li\xdd\x1c\x88\x9d0\t\xb9_\xb0da\xa71\x94A\x03\x85\xa4\x02\x94Q\x12t\xab\xd6\xdb\aw\x80\xd7\aդ\xa5\xc2\x1cV\x9dMj\x96\xe6!\x90\x98\xe5s\x8fhY\xb2\xfe?\x87\x94\x8c?\x96\xaf\x96\xb4\xbc\xdax𘂉Dd\xa0\xc6\xe4jF`\x99\xeb\xf5\ta\xda\x7f\x8b\xde.5A\x8b]W\xf5\xeeώ\x11\xa12}\xf5\xf8\xb9#\xcatG.\x94\xaf\xfel\x98`\x8c\xfd\x8d\xb3\xf5-\x19\xf0C\xfd\x99\x13\xc2f%\x03\xd2\x132c\x99\x06\xf9\x88\x13;\xe1\x12\x94콜\xe8J\x82\xc33\x15^K\xaa\x93\xc5\xe5\x03.\xbcU\x15klE\x8dǏ\x12V\xf7]\x9b\x93\xe9^\xa8\xe8}\xfcZ0\tK\xbb$\xbb]@\xe3\x1bB%\x90\xf3\xeb7\x90\ue5aeV\x12\xb61\x84\xf3Gh\xd6_\xeb\xfc\xd0v\x03pNJ\xe9Û\xe5\xa9:!\x94\xdc\xc1\xdaz\x17\xb8\xd87Q@\x81KL\xaa\a;A\xb9K\x82Y\xe3\x1bվ\x83\xb5\x01\xe2\x96\xed\a\x9em\xc7z\xb7\xee\x86\xf5\xe1\x9b\x1e\x91\r\xb1q\v,K?\xfc\x02\xc7d\xbej\xc9s\x1ft\xf1\x16f?o\x03L\x84\xbf<\xb5\x83\x87W\xb2\xa9\x8a\x13XF\x0eq\x99\x9f\x99\xa5\xacZ\xb0\xbc\x05\\\xa3\xe6(E&\x16\xea\x83.\x1f0|V\xe2g\xe5\xfb\x8a\x9f\x90k\xa1\xaf\xf8ɠ\x05Tr\xf9\xc00\u06002\xf1F\x80\xba\x16\xda|st\"Z\x94\x83Ih\x1f3*ĭ\x19\xc6\xf1\xd7c7\a\x85\xd8\xfe\\͌L\x95,a\x18\xce\xc7E\x84\xa5\x95\xf9\xa3{\xd9>k\xdf\xfc,\v\xa5q%\xc1\x05\x1f\x99\xc9n\xbc\xed=\x8e\xc4-\x05\xb9΅M\xb4\xcaW\xda\u05f5\x82x\x8b~\x92\x19\x14\xd2QB\x9eѤ\x8aZ\x9bH\x18\xd50g\tY\x82t\xe1\xe5CW\x8e6\xbb\xcd\xeb[\xd9\xd2\byj35\xfb\x8f3ƍ\xb0\xe0\xb6k\x84\xbay\xf0\x1e\xcf\xda\x037n\r}ŏ\xc3L\x92\xc6o8@\xcdzέ\xad\xf5nM\xf9\x86n\xd6PB\xc1\xa2dIs\xd4\xce\x7f\xe2Te\x84\xf6_$\xa7L\x1e\xd4\xd0s\x93aȠ\xf1\xa4\v\xbd\xd4_\x82\xf0\x99\"\xc8\xcd\x15\xcd\x1e\aP7?h29\x81\xcc\xf8\x03\x88\xd9cO\xe3\x84\xdc/\x84\x02d;\x99a\x06\x83<\x8a\xf3n^/\xee`\xfd\xe2dC\xc7_\\\xf1\x17vz\xde\xd0X?\x97\x1f\x00,x\xb6&/̓/\xe2]\x97VR\xd7\xe2&\xbe%D\xbaC\f\xeaa\xd2*>\xea\\\xd1\xf1\xa0\x83\xcca\f\xea\xbbm\xc1\xaf\x1d\x98L\xfc\xfdM\x0frK4\xe9\xc0\xca\xc6E\x86J\x13\xc9SBg\x1a\xa4\v\x88\x99\xefJ\xdf|<\x88\xb6}\r췠Y\x06\xbc\xa8\x0f\xc5\x19\xa2\xee\x81H\\h\xfc0r\xed\xbd;\xa4\xc6\xfe;\x1e\x8d\xe4\xf2\xa1\x16\xab\xa3܄\x1b\x1b\x038\xa6߉9\x0e\xdaL\xf9\xb4B\xf2\xc2>\xe7%ׁ1*L\xe5\xbc@\x93qHe\x9d \v\x1fI\xb4ɞ{\xa6\x17\x8c\x13\xea\x03\xf1 \x9d\xf0P\x92\x8bt\xb0\x17\x96\xbb\x16T\x91)\x00\xf7DK\x9fw\xa6]2~e\x80\x93\xd7G\x9d\x97IE\xa2\b\xf6y\xe2\x96\f,\xbf\xb03G[b\xdf/@BC\x066C\xc4Ư\xc3H]\xb5No\x05\xdb\xe11TdƤ*\xd7u\x16\xebB\xb5cl\x10\xb7\x10c\xac\x1b\x10\x85\x0e\xa6\xe9e\xf5l\xa9\xbe8\x82%}`\xcbbI\xe8R\x14\a']7\x9b͈f\xcb2I\xe6(zO\x996\x06\n\xa1\xa2%\xc3U\x8d/\x1ei\x05w\n3\f\xfa'\x82+\x96BYv\x81\xa3.\xd0\xeb!\x94\xcc(ˊͤEg\xca\nn\nJ\x82\xa9\xfa\xce>W\x8a\x0eN\x8c\xf7M´\x00Il6\a0X\xc44\x01\x9e /0N\x84\x06ּ\xc0\x11\x81\xcf7\xf3ջ>m\x8c1^\xc0\x8be\x9b\x81\x8f\x8c^2\xbe'\x9cT]#\xf2-e\xd9\xe0\xe0}alB\x19sB\x1c̪\x1f\xabg?\x82\x02T\xc6`\xaf3R]S\xccv\xd1t\xed\xb5\x80j\x8d\xcb@\xa3\x04\x82Ȃ\u05edؑ\xe5\xbf\xfd\x1aʽ\xff\xc0}\xad\x1cU\xfc\xc1\x9aƳA\x00\x13\xaf8\xab\xb8G\xb9\x01\xf0d\xde\a\x02/\xa7\"\x15,pW\x8d\xc7qR\xf0N+\x02\xae\xa6\x8b֞\xc8\x14\bMSHѰ\x1a\x7f\xc3\xfb\xb0\xb6\xb4dk:\xb7\xa33\xd1\x18P\xb9\x94\xab\x17]\xd5\x04\xbdM\xbc\xd2^kQ\x90{\x8a\xf52V\xb4K\xb7*\x17\xadf\xcd0>\xba\xb5\xb3\x9c\xb7\xbe\xf7\xd1\xc0\x87\xe7\xdei\xf4\x85U\xc0\xb5\\\x9b\x92\x9fv\xe8\xfa`\r\x90T$w\xe8\",\xe9\x1c\x86CE.\u07be\xf1\xfe\x02\x9a\xff\xd6\xd6ݱ\xd2\xe6\x18s)V,EW\xe6\x03\x95\fS\x1fD\xc2\f$pL\x00}\xf9\xf2\xc3\xf9\xfb_\xae\xcf\xdf^\xbe\n\x00\x8d\xf1Fx\xc8)G\x89+\x94\x9f\x8dK~#\xf2\xc0WL\n\xbe\x840:\\\xcd\b%+\x8fiR\xd6A\xe1\xc2&[Az\xe2\xf2#n\x04\x01\x90]`\x81\xf1\xbc\xd0\xce\xf6\x91{\x96e\xe8\xef\x15<YP>G*\xdd.\xday$\xf6\xaaя\xa85\xd7\xf4\x81$\x94#HP\t\xcd!5\xf2Kh\x00\xc8T\x148\xf4/\xbf<!\f\xceȗ\xb5W\x8cɥ\x83Z\x12 D\"\xcch9\xac@\x92i\xc5\xc0\x13\"aNe\x9a\x81Rh\x81\xee\x17\xa0\x17\xd0.h\xe9\xec\xcf\x02*\x96\x81\x8fz\xa2\xf4m\xabd\v\x00\xbc\xa5\xca\xed\xae,\xc9\xc4B\xb7T$\xeaTSu\xa7N\x19\xc7)e\x84\x95h\xa3\x9a\x11:\xb53\xc2\xc8\xcdN#\xbf\xc6\x1b\x95\xc2z\xfa\x85,8\x96\xea\x8ehy\x17\xe3#:R\vȲ\xe1`\an]Lg\xf0,\x1c\xb7\xca\n^(o\xb3o\x97\xa59\xb3k\xbb1f\x19\xca\x05Rk\xa0\xa42䆮\xe3\xad\x16\xef\xf2\xfa\xf6\xfd_'ﮮo\x03\x00?2\x91\xbb\r_\x00\xcc\xed&r\x8b\xe1\v\x80\xb9\xd7D6\r_\x00ԃ&ҭ\x8b\x03@\xb60\x91u\xaa\x04@\xdeg\"k\x86/\x04\xd7\x16&Ҍ!\x00fo\"\xff\xc3L$\xf0U\xa4y\xfc\xc1\xb9\xed5U.\xf9\x1c25kar\xbc\x8c7\xadD'\xe1\b\xa6vcd\x97|\xf5\x816Sؼ>\xcc\x00\xb8\xa4\x12}\a\fm\x12\xadby!\x02\x1f\xeeݷ\xc9l\xb4 \x88ߊ\x86\xc65\x96\x0euZ\x8c\xc9[\x97ӥ\xe4◫7\x97\u05f7W\xdf^]\xbe\x0f!F\xb4\x8e\x94\xa9\xf9N$\x19\x1eoI\xb1wa\x91KX1Q\x94\xe5\xb9\xc1pk\xfc*\xe9\xaf6\xb4-\x1c]L\x1a\xf05\xc1\x1dQ,i\x88E\xf5\x9aP~\xb6X\x03\x05C\xdc\xe6\x104\xa6\xf9`\x88Gu\vZ;\a\xc10\x9f`\x15\xd5v-\x15\f\xb2r,v\xb8\v\xc1\x10\x8d{\xf1\x06f\xb4\xc8l|\xe2ŋ\xf1p\x10(:\x9d\xcc˷R\xb4\n \xef417&)Z\xc6Nk\x1a\x16mx\x87\xae\xbc\xae1\xb9\xda\x05D\x04̬\x00\xbf\xe2\b\xa8\xcd\xe9>\x9f\xb94ڌ\xcd\xdf\xd2\xfc{X\xbf\x87Y8\x80\xc7\xc46\x95w\xaeX\r\xe7::\b\x06H\b\xce\xeb\x16\xadp\xd3\u05cd\x1e\x01\xf5\x88\aiq\xeb\xaa&\x8dg\x86d\x89\x19L'\x05\xea\xe2\xb9l\x1dҰ\xee\xc28\xdb\x17=\xac\xb6K\x8fD\xf0\x04r\xadN\xc5\ngI\xb8?\xbd\x17\xf2\x0e\xc3-h\xd9G6\x13\xa0Nq\x90\xea\xf4\v\xf3\xbfh\x8cn߽ywF\xceӔ\bcF\v\x05\xb3\"\xb3%>j\x1c\r\xb6\xda\xd8{b\xb6\x99\x9e\x90\x82\xa5\xdf\f\aQ\xc0\xba˃0\xec\xa4\xd9Qd\x02\xf7W\xb1\xd9:bIۼP\xa4J\xbdǥ-&\x1eP\x7f\xb0p1\x1a\xea\x14\xa2]\xbe:\xb1\xa7Bd@y\x04\x8c\xb6\xe9\xafز\xc2N)\xb2m\x97\x91\xf5c\xcc\x05\xc3j200\xeb[\xe8C>\xae\x14⌨\"υԊ\x94\xfd\x0eP\xd9O\x06\xc1\x10k{\x8e\xc7\xe5\xee\x9d\x13\xf2\xf7\xf2KSS\xae~\x1a\x0e\xff\xf8\xfd\xe5_\xff\xdfp\xf8\xf3\xdf\xe3\xdeRA\xac5S\xe9\x0e\x16\v\x02\xc6\\\xa4\x80\xe6\xf8\xc4\xd4\a\x8c\xdd\n\xe2<1\xe9\xfd\xebh¸\x9e\x16\v\xa1\xf4\xd5\xe4\xc4\xff\x9a\x8b\xf4\xf1oj<|\x86\xc9y{\x8b\x84h\x19u\xb0ܔ\x16\t\x91\xf8\x9e\v(\xa9\xa6\x9f\x05\xf6\xe5@\x9f\xee^2\xad!\xc6l\xb8\x00\f'\x1a\xe4\x12C\x86'$\xad\xbb\xe1\xab\xd7/\xc6\xcf5}\xcc\xfc\x10\x8f\xc2\x02C+\xe7R\x18ȑ@]\b\fM\x8e_\x9f\x965W\xd1 \xcf'W\xbe\xb5\xc63\x91\xbb\xdb\xfcQ\xb2\xeac\xcf\"\xbe\x8c\xf4\xdb'\x98M<\xec\b\x90\xc4iz\x15\xb29\xb3\xf5\xd3\x1ef\xf8\xa2\x1b\xaf\x8c-\x99\xdb\vSv\xe1xi\xbf\x1c'y\x11g\x89\xdd\xf3KX\n\xb9>\xf1\xbfB\xbe\x80%H\x9a\x8d\xb0$\x83\xce#ͼGӠW\"\xed^\x16\x05\xb1>\xf8M,Ã9>\x9a\x97\x14\x12W\x19\xd9\xda\xcf\xff\x90>\xcb\xccSJ̶& q\"]\x86\xaf;\xad\xd0*\x1ba\x82\x1c+\xec\x94\x06\xea\xa4\xf4\xf2\xa3\xc1\"4\xe0+\f{4\x9a\xb8|D\xebGH\xcaVL\xb5+\x9e\xdc\xf6\xa1|\xfd.\xca\xf8\xe0\xcfh\xa3\xedV\x17(\x1d\x88\xf0Hpnܼf\xeb\x97E\xa1\xf3\"\xdcB\xfb\xcfL\xc8%\xd5\xde.\xc2C.0\x92U\xda\xc38\xf3\x82W\xc3_y\xfd\"\x12N\x8e\xb5\x8a\x92\x9f\x91\xffz\xf9\xb7\xdf\xfd6z\xf5\xcd˗?}5\xfa\xbf?\xff\xee\xe5\xdf\xc6\xe6\x1f\xff\xeb\xd57\xaf~\xf3\xbf\xfc\xeeի\x97/\x7f\xfa\xfe\xed\x9fo'\x97?\xb3W\xbf\xfdċ\xe5\x9d\xfd\xed\xb7\x97?\xc1\xe5\xcf-\x81\xbcz\xf5͗\x91\b?\x8c\xaa\x18ƈq=\x12rdY\x7f`\xbb\xf4\xbe˳\xe3\xec\x18\xe23|\xef}\x8a\x12nw\x9fk\xf89\xbaG\x1d\x86\xdf\xc9;R\x90HПV\xcc\xd5\xe2\xe4]g\xbb\xf7\xa0\\\x1c?\xc3|{\xec0l\xd7%\x9e%O\xb5\xc6\xc0-;cbR\xb0\xd1@M\xeaִ2\xf4\xf0\xef 8\xfe\x7f$M\xea\xc3\xc4}\x98\xf83\t\x13\xdfX]\xe9c\xc4\xcf\x13#\x8e|4f\x94#c\x94\x06O\x8c[T\xbdWXbzk͗s\xb1щ\xcaE^`\xb3\x95\xc8\u00a0\xdd%)c?\x01\xc6ԾT\x15\xb7\x06S\xb2\xec\\ot\x9ee\x84q;\xe5\x19\xa4|\x19\x88\x04\xbb\xb6\xc7v\xd9AJ\x04+,\x96)\xfbL\x97\x03\xc7\xf8\xabis\xcd\xf8|L~\\\x04\x85am\xfe\xda\xd5M0N\x96E\xa6Y\x9e\x81#\x84\xaa\xf5\xd7\b\x81\xaa\x94H\x18\x16h\x9aZf\u05feFiO^C\vM\xefB\xbc\x94\\B\x02)\x16Na\x99\xb2\xe9\x1e\xe0\xf8L\xa6kB9\xb9\xe4+\xf3\xb6\x10<IZ\xd8\xe2N#9\x15^\x8d\xb7\xd9ڇ\x00\xb0\xcfR\x82\x88j\xeaJ@j\x95\x88\xa1\x9e\xa0c\x90\x98U\xadt\xca\\\xa5\x1a<\xbdS\\\xd6iD,\x18\x1a\x14\xb9mdYKo6\x10$\xa9\x9a\xe7?\xfdػ\xb8\xa6O\xe5\x96~Z.\xe9\x13\xb8\xa3\xc7sE;\xb9\xa1]\\\xd0}\xeeg\xf4R\xb0\xd2\x1d?\x17\x86Ϫ\xc7p\x1b#}0\xd4B\x98\xb1\x87\xb3A\aZ\x9e\xf3ri@X\n\\c,2ܣG\xafGB\x0e\xdc\xec9\x05\x9a,\xccd\xe3\x1c\x98\x92\xd0\xe1\xf2\xfb\xccU\xd1v%\x7f\fC}\xb3-\xe6\xd0[\xdd\xde\xea\xfe\xa7Y]\xa7\b\x9f\xa5\xc9\xfdH+R\xb3\x03\xf2l\x10Ŧ\xe1\x9b\xda.J\xa3\xf5\xf5\xf3!Z\xc3$\xad\xb4\xb2\\\xa0\xa9S\xf3\xbe\x10\xe53\r\t}\xbf\xb5j\x12\u0096\x05Y&\xeeɂ\xcdQ\xcc2<\xa6\"\x00\xac\xf5\xaeɒr:7]\xd3\xd0\xe4\xba\xf4\x15V\"\xa2!\x91,\r\x91\xdd\xda2\xd4\f\x12\xe3\xea\xe8\xfce\x82\xa6\xb5\x83\xb4B\x06\x9f\xb1; o \xcf\xc4\xdauv\xe3)\x1eۤ\xd1ٻ\x01\x1dR\x90\x15a\x1e\f\xb3&E\x96MDƒu\xac\xa8]!\x18\x92\x17YFr\x03hL\xdeaS\xfe\x199\xcf\xee\xe9zg\xa7\xfcm\xd75\xee\x9e8!W\xb3k\xa1'v_Xs\xb7\x82\x05\x19\x00\x91\xcd\xc8\x19\x86a\x94&\x9a\xceM\b\xc1\xd7\x10\x9d\xa0$\xd4_\x15\x00ָ\xe5\xf7L\xc1\xb6\xedx\x1fQվ0\xef\xc4\x05\x88\xe1\xa6zR\x81\xc9\xd8\f\x92u\x92\xc5Z\xa5\xf3\x04\xff\uf3a0\xc0%[M?\xd5Zi\bY\x80\xba6:&\x88\xc1L{\xb4\\p\x05($\x95\xaa\x96\x18\a\x006\xe1'\xb5\x8d\xaf\x83\xa7uѰ\xc7\xe1\rƷB\x1ez\xac\x8d\x13\x0f\x04E=\xa1Y\x86\x9bX\x96KH1J\x95\xb5\x9d{\xfc\xc7w\xab\xab(\x8aP\xf1L2\xd7\b-|\xfe_P\x9ef Mo.\x17uk@\xc7\xf2H\xc6iX#\x81\xaa\\ɝ\x83Gh\x92\b\x99\xba~H\xbe\xe3\r\x95!:\x8eWi\xd1P\xdf\xeb\xf2*fM\xd4\x03\xe1N3\x91\xdc)RpͲ\xaa\x05\x9a\xef\x7f\xe6\x0e\xd1\n\x84\xd9ޏ.\xb1\xae\xfdsT\xea\xcah\x81m1O\xbf\xa8\xfed\xbehoZ\xe2U\xa0m\x8f\xc9\x03Z\x80\xf3\x0f\x8a\x83)\x044'\xc4Ħ\x8ag\x02\xdd\x10\x14#go\xa6\xb5\"Աi\x93\x17\x01\xd5Cp\x87\xd2\x19\xb3\x88\x86\v\x8dY\xf8:#\x9e\xd4Q\xbd@vR}{\x1b\xcd(\xb88\xd7p\xa8\xf7\xd3d\xa6\xcb_S\xe7b+\x99\x10\x88[A\x92\x94Iӌ\x7f\xed\xf7\x13F\xc2t\xa35=\x96\xa4\x10\x9a\xbc\x1c\x9e\x0e_\xb9\xe4M4L7P\xd342\x03;G\x86\xf6#چ%\xbaAl\x99g\x98\x11\x81d\x98\xe2\xf9(\x91 \xddFG\xec\xcb\xe5x\xe4ڹ\x9c\x10%\x06\xc1\xe0̏\x96\xd4w\xae\xb6\xb0\b\xe3J\xcb\xc2(\x8a\x1a\x04\xc33?/\x87\xbf\rO\b\xe8\xe4\x15\xb9\x17|\xa8\x8d\b\x8cɭ\xc0u~$\xccr\xa8آ\x8c\x83m\xb6\x06\x0f\x98ja:[GB\xc5i\x9b`\xe7M4\tx\x04\x82k\x8fs\xf9\x10\xcd%w|\xad\x98\x91\xafPB\xb5\x9d\xc215\x97\xb1\x15\x9c.\x80fz\x11\x8b/J\x14\xf6\xbd\xff\a\xb6\xb1\xc4\xd6;\xdc\xc1\v\xb7eQ\x19\xa2\x8enmׅz\xc7\xc8@\xe5\xfd\xff\x19tǉ\xef\xbb\xdb\xdbɟ\xa1\xeaM\x1b\x9e\x17\xab\xb0\xf1\xb5\xdf(\xd29H\xac*\xfd\xd8s\x13\xeeY:\xc2\xc4\xf4\x1d\x1e`\x87A\x10\xb78\xe0\xe1\xec\xf1\x1f-\x9a\xdbv\\e\x1d\xb9\x9a\xc4\xc9:!\x7f\x15\x05\xae\x17\xa6t\x9a\xad\xcb.\x87\xd8\xf8\xe5\x05\xa2\x1d[d˸\t\xdd|\a4\xc5ưh>\x81\x06\xac`\x8e\xa8R5<\x8e\xc0K{\xd09Y\xb8\x81\xb5l\x97\xbay\xd5Z\xeb89\x1f\x1b\xed\xb1q\xa7\xd89\x06\xb3\x1fư:\xfc\x9e\xc1\x006%\xff\xf6vbi\xef\xa88\x8d\f\x8d\xe3\x0f\xf5\x87I\xda\xc1\xb9\x1e\xa3؊2\x1a$\xe3\x06E\xa3\x00јu\xb31\xdd\x12#[\xa9\x8e\x99\x1eK\xa3\x0e\x10ݮ\xbc\xd0r\xa9#+o\xad\xa5ŧI\x9eЊ\x9d'\xa0O\x97b\xbf\xa8\x92\xb8\xfa5\xeaD\x81\x0e\x0eKwo\xc9\x1c\x1d\xb48\x1bt\x16(\xb3\xe1\x14S\x06Ib\xba\xf1\x85\xe6\x81\xfc\a'sc\x8ep\xebuX\v\xb2\xa3\t\x14\xd6\xccő\xa4\xc3ƨcl\x8b:¦\xa8\x06Smi\x8f$\xbcXNAƶ\x1a\xf0\xcd\x06\xa4n\bH3\x8e\x10\xc7hB\xae-j>\x89\xe9\xdd\t\xec}\x15\t\xf15b\xf9\x87\xdf\xff\xfe\xebߏ-\x01<l\xca#!^\x9d_\x9f\xffr\xf3\xe1\xc2\xf4\xb9\x1a\x0f>\x91\xfdOf{=\x9cu\x97\x92\x1b\x03\b\xa9V(\xc0\x10N\x14H\xe2W\x05.^\x8cҁk\x8f*\xf7\x14\tV\v\xe3\xdf<\x83%\x89\x9f\x94FF]\x06\x1fq*\xd1I~\x83\xf9\xea\b\xc3\xd7\x10\x86\xe1\xed\xc5\xc4\x02\xaa\x16\xc0\xc1\x10ѐ\x12j\"MX\xd7,\xb2\x15\n\x05%\xb7\x17\x13C\x98\x18^\xe2\xb3&\x86nBek\xd0\xd5\xceg[t\x12\x01\x13\xc3w6\x15\x81\xfb\xe7)\x1e\x16\xc0\x12\x83eL\xd2\xcb\x7f\x10\xcb\xe1\xe0\xe3z\xe0GZ\xe5\x0f\xdf\xf9\"\x97j\xc1\x1f\x05\x95\xd4\xc2\x04\xdb\x16\xfc\x91@]\x98`\xf8\xf1mA\xefUT^\x85\xf3&\xa4?\x9f\xae\xf7*\xfe]\xbc\x8a\xcfgƋ|0\x97p\xa3E~6\x88\x96\xfe\xe1Ă8Jm\x80?yhW\xfa\x9e\xa4\xc1LDe\xe2\xa6E\x8f\x8f=\x8bF\xd2ݔf\x04\xc2TE\xb2\xf0y\x0e\x0eJ\x9d\x9a2\x80\"\xb71'\x7fDXh*1\x97\x80\xad=M]\xa7\xdfsn\b\x81\xc5\xd3\xf8%\xe8$T/L\xd8\xc8UG\xb8\xac\x9agR\xb7b\x83DR\xb5\x00s\x00\a<\xb0\xea8t\xaa\x04G\x9f\xb9d\x1a\x13\xa1\x06\x81)\x92S\xa5l\xe2KW\x030IJ2\x11\xe9p\x18\xea\x82Ր!sI\x13 9H&\xb0Ȯ\xe0:\x15\xf7x\x96\xca\xfc\xf0)\xaa;\xe4\x15\x91\xf4j\x80\xde\x0e\x92W\x95\x87W\x84\xf2\xec}\xd9\xdb\xd7W\x84\x88B'\xa2\xaa\x8fv\xf4\b\x95\xaf\x06\xbb\xedv-#\xfc\x05ͲuI\xa2P\xfdr\xbb\xfftɚMb\aB\xb4\xac\xf9\xe8\xf51(ʦv&\x10,\xa2\xb4S\xbe0s\x8f\x9b\x16¥\xa0\xaa\xf7\xeb\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo\xfa\xf2\x9b\xbe\xfc\xa6/\xbf\xe9\xcbo>\xf1\U0009b207|\xc5\xc9\x04\vM\xce\x06Q\n3\x9c\x98\x04;K\\\xb9\x8a\x98U\x12\xde\x1ab\x85ʸ:`\xbd֧\xd7\xf7\xcc\b:\xec\x16\xb5\xa2*\xa1\xd9\xda/%\xb4\x89E\xfb\f\xbao\xbc\xa4Nsa\xffS\xe5\xcfk\x89s\x83_@\xe6<n\"\rϘ\xb7ɖW\xb9\xef \xd0dw\xa6<\xda+\xeb\x9a%\x8f\xf7O\\\xc24\xf4\xb1\xa7ʌ?UV|oF\xdc\xe3\x8b\xc5V\x11\xb07\xb2\xe1\x15\xaaͶ\x12\x11\xb0o\x17p\xec\x9c\xf6\xde|v=3\x1d\x01{3\x97\xbd\x91\x95\x8e\x80Z\xcfco\xcdHG\xc0\xacrػ\xb2\xd1\x11@1\x7f\xfdt\x99\xe8#f\xa1\xa3\x130\x9d\x9c\xd5\xd8Xj\x94;A|\xe1\xe9\xedB\x82Z\x88,\xed0\x83\xbce\x9c-\x8b%*\xb6B\xc3\xc4Ve]k\xa8\xc5\xf06\xc7̜.ń`Y\n\xe68:ʲ\xe0|\x93m\"\xb6\xa0f%\xaf\x8a$\x01H!\xad\x82;\xe1*\xf2\xf5\xb8\x1csy\xda\xfe\xeb09\xc3v\x16T\x9b-\x8f_\xff\xef\xa0'cWUQ%\x06\x87\xcb\vL\xc5\xe1 \xea\xac\xc8\xe8҂\xf8\t=.\xd8\xf0\x14\xe5\x04{J\t\xb0( \x02\xe2\x9e2\x82G\x05\x01\x11\xc0\xa3K\b:\xd8\xc4N\xa5\x03\xfb\xcb\x06\x906\xc1 ɾ\x92\x812\xf9\x1f\x016\xba\\ z\xa6z\x9a2\x81\xdd%\x02\x84\xc5\xc5\x1a\xba\x95\a\xc4ۉ\xeee\x01;r\xde\x1dO\xa4\xee\x12\xd5\xec\xe2\x9ct.\x03x\x1artO~G\xd3#>\xde\xd4!\xe5\x1f\x9f\xee\x8f\xf4\x12\xbb\xb9\xa6\xb1)\xfe\xfd\xe9\xfd\xc8 |\xa7\xd4~\aa\x89\v\xbeG\x06\u07bb\x06\xdd;\x06\xdc\xf7\xa7\xf0#\x19\xf7\x04\x81\xf6=Av\xf2:nɼ=\xc0\xde5T~\xe40yl\xe2}\x7f\xd2\xdd{\xc11\x12C\xb6'\xdc\xe3S\xe7\xd1\xf2\x1bg\xd0#\x92\a\x91\xa6\x98q\xa6\x19\xcd\xde@F\xd77\x90\b\x9e\x06z5\r&\x0e\x9d\nࡁ\x16\x98]'w\xda'\xb8\xa0\xee\x84<H\xfdvG\x1f\xf9\x0f\x84\x8bk\x19P\xe6\xb8~;\xeeG}\xed\x9f3J\xff<\xcbw\xbbI\xb0;\xe3\xbf\x13\xf7D\xcc4p\xf2\x92q\xcf\xfbW\xe16\xcf-ܫhM\xa9\xbc\xa8\xbb\xaf\xbf\xf2\xa0C5\xf8\xf3\v\xac\x98\x90\x92RO\x15Is\xe0\x8f\x1dJs`gE\xd6%\x9c\x86a\xbeG\xb1\xb4P\x86U\xc7k\xbd68{\x8ba\x92Rn\xb3\xfc\xbf\xbf\x10E\x16A\x1d,\x80\xaaʙ\x82\xe0\x92\xed\xc5O\xcdR\xa6@\x88[\n\x9f\xb6\x971\x05\xc2m\x14=E\x940=k4\xf1HeK\xfbK\x96p\x8fR\x04Шr\xa5~\xa5\x14\xb1Rz\\\x96ԯ\x94\x9ew\xa5\xf4\xa9\xaf\x054[\x82(\xf4'\xb3\f\xb8_\xb0dQ\xf76\xd8\x12\xfb\xbd\x14\xf1%\xd4\xe8C:\x94\xb6&۞\xf6\x80\x9a\x7f\xa3\x95C\x84\x84\x85\x85\xbd\x9b\x96\xacv4gI\xa7\xd2\x1b\t\x99\x84\xf0\xd4v\xf2\xe6\xfa\xe6\x97\x1f\xce\xfft\xf9Ø\\\xe2q\xae\x15Hs\x88|شf\xa22\v\xba\u0092\x8e\x82\xb3_\v\xb0\xe6\xf6e\xf9\x96W\xbe\x8a,\x00j\xcc\xf9\\\x113\aZ\x16\x15ɔ\x1f\x982\aF\x19\x18\xe8\xa1\xc3C.0t\x13v\xf8ks.!\x97\b\x04S\xea\xd4\xce;\v\x90@\xe6l\x15\xb4PA\x98\xb6\xaf\x05\xa1i\xd9\xf4\x01\x15\x15\x1dp\xec\x8bB\xa7\xa2\b\xe1\aB\xe4\xa0Q\x83˸\x14\x1e\xfaV\xef\x13V(\b:\x16pZh,)\xc9%[Rɲu\x1dA\x9a\x8dɵ\xf0\x1e\xf7\xba=G\xf1\xaa\x93\xeeͻ\xcb\x1br\xfd\xee\x16\xcf0\xc6VK\xf6\xe8\x15\xf3\xf7@FM\x01\xd9b\x99\x9c\x8e\xc99_\xdb\xd7X+Ͱ\x17\x99\xd2\xc0\xc3Pu΄\xf3,ɋ\xaf\xc6\xe6z\x81|\x93\xe8m\xd8b\xb4\x00\x88u\x8e\xf8bP\x1b\xe3e\xd3\xccJg\xa0\x1f\xe4\xf8\xbe\xad\x16t\xf0d)Ն\xaa\x95\xe5\xad\x13$\xb8\x84ܞ\xec\xa8\b\r\x80X\x0eĲ͘:\xc5\xf8<\xab\xeb\xdf\xe0\xe9\x178\xe5\xcb&\x11\x8ey\x83,\x95\x97\xe1]T+\x9d\x810K)\xccE:T\xe4j\xe2\x85\x0f\x9b\xe20e\xbc\xc9`\x90\xe8}bZ\x8d\xa5\x96ܶ\xe1\xf7\t\xf9\x8a\xfc\x91<\x90?\x1aw\xf5\x0f!\xe4\xee6\xcb\xc7\xce\xf3~=z5\xe9ĩ\x1f\xd1\xe8 \x1c\xa4.\xe6\xef\x19O\x03\xb5З\x10j\x90x\x96\xae\xe3x(\x05\xa3WW\x88\xfc''\xb0\x88\x949\xb0\xb2t\x85\xf0\xe8\xc9OJd\t\xa2\x87\xd5B\xd7\xce\xf84ϪEl\x83!\xa2B\x92%\xd5ɢ*\xfcG\xde\xe0\xf9\x92JW\xd6,\x1cr*0\x02\xe5J\\\x17L}\x1e\n\x1aSPҐ\xcbcJУ%\xb7\x89\xb7:\xbf\xd86j\f\x86\xeaL\xb3s\xd6q\xb0N@#\xbc\xf5\xbd>\xbb\x8b\x1e\xc4l\xf8\xad\xb6n\xa1\xa5K(v\xf3$\x12f 1*\x8e\x16/\xb4\xc6\x01\xbb\xc9\xc8\x15K@}4\x1b\x97K\xa1E\"\xb2N\xb24q@P\x17\\x\xf7m\xa4,\xfd\xe5\xcd\xe4\x04c\xc3\xe6H뛋\xdbI##\x10\f\xf1\xc5\xed\xc5\xe4\xc5G\"fL\xa8gTY\xaeIX\xc4gT\xb2n\xf0\xc4A\xa2\x98\x9a\x9dF\f\r\x17\t\xa3%\xcdGw\xb0\x0ep\x1cci\x13A\x99Mt\xed\xa0\x974o\tC\x02M\xd9'\xb2G\xce\x19\x91\n\xa7\xed\x9b\xe5\x96b\x15Tcj\x96Q\x1e6\xf04\x17\f\xd7#l\xb6\xb1\x83.\x00莽v\xcf\x1fa\xebw\xd0\xf5;\xe8\xfa\x1dt\xfd\x0e\xba~\a]\xbf\x83\xae\xdfA\xd7\xef\xa0\xebw\xd0\xf5;\xe8\xfa\x1dt\xfd\x0e\xba~\a]\xbf\x83\xae\xdfA\xd7\xef\xa0\xebw\xd0\xf5;\xe8\xfa\x1dt\x87w\xd0\xfd\x0f{\xd7\xd6ܸ\x8d\xa5\xdf\xf5+P\xae\xa9\xb5\xbd\xb1\xd4ݩ\xd4Ԍ_R\x9e\xbe\xa4\xbc\xe9v\xbb\xdaNg\xa7:\xd9\x14DB\x12\xd6\x14\xc0%H\xb9\xb5\x9b\xfd\xefS\xdf\x01\xc0\x8bH\xc9\x02\xd5v2\x19\x8e\x1f&m\x93\x87\xc0\xc1\xb9\xe1\\\x1f\xfc\xaf\x7fͼС\x82n\xa8\xa0\x1b*\xe8\x86\n\xba\xa1\x82n\xa8\xa0\x1b*\xe8\x86\n\xba\xa1\x82n\xa8\xa0\x1b*\xe8\x86\n\xba\xa1\x82n\xa8\xa0\x1b*\xe8\x86\n:_A\xe7G\xf2\a\x10V\x93\xa8^\xeae\x8a\xfc\x94\x0f\x1eP\xc9Pa\xf9\xa9\x94!\\\x89\xafm\x89[\xa3\xc7 \x81H\xab\x99\x9c\x17\x19\xd5q=\xb3\xb3\xd9Ǒ\xddظ\xc4и\\ݳ\xe3\xd1\xe3\x1a\x1c\x89\\ʐ\":\xfcTUi\u05fd\x8d\x9c^\xfa\xf50\xedz\x90nMy\x8eڍs\xf6_'?}\xf5\xeb\xf8\xf4ۓ\x93O\xcf\xc7\x7f\xfd\xf9\xab\x93\x9f&\xf4\x1f\xff~\xfa\xed\xe9\xaf\xfe\x1f_\x9d\x9e\x9e\x9c|\xfa\xfe\xddw\xb7ׯ\x7f\x96\xa7\xbf~R\xc5\xf2\xce\xfe\xebדO\xe2\xf5\xcf{\x029=\xfd\xf6O\xa3\xdfPc5\x19\xf0-ъ\xfb\xe5\xd4\x05\xea\x97\xfc3\xa4h\xe0*\xf9R\x17\x8a\n0\x1d\xf1W\xe2\xc1\xf6\x0e\x15q\xf0\xed,̍\xf3\x88\x9c\xd8S@z\x13A\x98\x81!\a\x86܇!?8j\xd9dIk\xd8|A\x96\xf4\x8a6\x94'/g\xac\\\xa34L/e\x8e\xbc<8dx\xff\xe4R\x997\xae\xa2N,Q\xf66\xa7\xa2\xe4\xde\xe3\xe6kuD:_\x88\xec^\x1arrqU\xf9\x14H`\x8cc1\x93*\xb8\xb11y\x8e&\x7f\x04Q\xd5\xe3%d\xf1e2_#\x83_|\x0e\xb8\x937\x89\xfeƁa\x9a~c\xbc+¥\x88\xef\r\x95\xd1@\vTu\x05\x1fH\xaa\x13\x19\xad\x9f\xf9\r\x91\x92\x10\x9f\xf3g\x01\xdf\xde\xef\x8b97w\xd5\xf9\x8b1J\x02\xaacn}\xff\xb1\x8dE\xd2\xccי\\\xc9D\xcc\xc5k\x13\xf1\x84\xb8\xe1\xfc\x00\x19v\xb1\x05f\x10HL\xa5Qy\xa6\x13\xc3\xee\x17\x02\x9c\x8bںL\xc3\x17M\xf5ls\x1e\\\xba\xb7\xc4\t\xa5~a 3H\x81ܰ\x94ghE\xe0\xc0\x87\x8aD*ʞj\x9d\xb8\xa92ɺZ\xbb+@Q\xfa\x17%\xee\x7f\xc1\xb7\x83\xdd\xf3\t\x9f\x97\x851\x18\xe8\xbe\xe9\xad\xe9\xbb\xecm\xc7\x04q\x8b\xa6\xab\x8c'\xf7|\x1d\xba\xdc\xfb\x85\xd8\\\x9f4\xe7\xec\xc5)\xf1&7\xac\xfcb\xa8\xa4\xfd\xfa\x94\xe2\x86//\xae\x7f\xb9\xf9\xfb\xcd/\x17\xaf\xde]^\xf5\x11\x8b8)\x114\x14.\xe2)\x9f\xcaD\x86\x1ba\r\xc6@6S\x1d\x14\xa9\xa18~\x16g:41\x96\xb0\x9c\x15\n\xdd-*L\x9bF|%\x10d\xbd\xed\x05\x91٬\xb9\xd8y\xc6Ux\xd6\xe2t\xbdA\fY\xa1\xe0\xf4\t#\xd6~\xb2\xcd\xd9ѡ\xafl\x9c\xdaE\x1c\x8b\xb8\x81\x8a\xdfh~\xc1K\xbf\x84u\xd5q\xa3\aLƮ\xdf\xdf\\\xfeg\xf3p\xc1\x19=`\x1d`\xec\x1f\x92,\x06\x869\xf0T?\xd8\n\xc3\xe1\\\x7f?\xe7\xda\xcbhe\x95>?$\x9e\xfe\xa1P5\x19%U\rj\x10PƖ:\x16\x13vmU\xb20MX\xd57B\x89\r\t.\b\xee+4\xc7N\xd6\f\xb7\xb7\x15O`\xb5\xe4\xda\xd6\xce\x05\x1bX\xdd\xd9T3\x9e\x181y\x12\xbd\n\xc3\xe5\x1d\xbcF\a\x9c\\\t\x83\xc5B\xe9\xdcݗ{\xd0=\x9a\xa0d:b\xf6\xce\\KZk\xe8\xaf`+붦V\xa5\xf1\x98\xbe.WM\x11\x91@\x98h\xecխV\xfd\xa7B\xc9\v\xd7wTdSm/rqmVŒ\x9b;\x11\xd3x\x8b\x1e\x1b\x97\xa5\x97\xc1\x1eJ\xb9\xe9\xdbu*\xd8L\xf0\xbc\b\x0e͐5lsT\x84\xe2\xd3$ԁ\xd1S\xb2\x017\xefU\xb2\xfe\xa0u\xfe\xa6\x1c\xe6x\x00\xd9\xfe\xe8\xee4\xcd\xc8\x05\f\xdc \x98(\xa5\xc0\xda\xc6tp$\x06j\x95\xb2\x9e\xda\x02AJ\xf3\x94B +ԅ\xf9.\xd3Ez\x00:\xc1e\xdf]\xbe\x82\xfc\xc25\x03\xd4&T\x9e\xad\xa9\r@\x10X\xc6\xf4l\xcb\xfd\x8a\xfd\x00\xbes\x9c\x16\b\xb4\x14\x013V(#Є\x84\xaf\x19O\x8c\xf6\u05fa\xe0\xdb\xec5\xf5ɯ\xfb_&䞃\xf1.\x15\x9b\xea|\x11\bq\x03\x1c\x89\x80\xf6WB}{@&y\xc9\xcad\xa3\x18Zq\x03j(P~'ЪPD\"\x16*\x12\x93\xbe\xb1\xd5?\x7f\x13\xf4f_\xe78Q\xf9\x95V\x10 \a\xd0\xf9\xa5\x8aeĭ\x96\xe3y\x93NG=z\x0e\xb9;9\xa7\x8ah\x12\x1f\x85\x11\x19\xb5\xf0\x82\v\xa0\xcfQ\x7f_LE\"r베\x86s<\x17\xb4R\xb9\xe4\xc1\xd3\xddy^\xaa6t'S\xa6Ȅs\n\xe7,֢O~\x99\xdb\xf4\x0f\x97\xaf\xd8sv\x82]\x9f\x12\xa9\xa3\xd2\x19\x12\x84\xba\xf1\a\xc2lJ\f9\xf3\xcb#T\x12ǳ\xe0.N$\x84Ϙ\xd2\xc8\xc1\\x\\\xa2\xbb\x85w\a\xb9\xdc\xdap/~[\xf8l\x13'\x81\x80k\xc2\xe7_G\x9c\x1c\xa4\xfa~0\";P\xf3\xfd\xf0蚯\xbf[\t\xf2\xa4yR$\x06\xd8R\xe4<\xe69\x0f\x1b\x87\x8f\x9fB\x95\xe0&\x03!\x7fQB~z\xbdh\xc4[\xa9\x8a\xcfv<\x849\x90\x0fn^\x130\xe6\x82'\x90\xe5\xd3`\x85\x93\xa6\x89\xb4-\xf2\x1a\xbc\xe0\x05\xb9?\xaa>\xa7]1\x96\xd7i$\xc8\x11\x83\x81R\x0f])˸\x8a\xf5\xb2\xb5m\\\xe6D\xa3\x8f\xf8\x84$~(\xfc\x81\xad\xbe\x10[\xf5w_'b%\x82\xdb\x1fnp\xc6[\xc0@P\xc7\xd3\t\x01\r\x86\xc9X§\"\xb1Ɨ\xe5\x922m\xbc\"\xb4\xd1\x13\xba\x1a3\x9d\x1cZ\xa2\xf8A'T\xf6\xc1K\xe4\x00\xe8\x1f\x007\xf4\xeaa\xb8\xb9]\xa7\x1b\xb8\xe9\xe9M\xfe\xbd\xe1\xa6\b\xb6\xb8Z\xb8\x81\xd1\xd6\xc4\r\x80\xfe\xd3㦧\vވ\b\xb9+י\x9e\xc9P\x96l\x92\x1c\xe6$X`U.\byb\xfb\x84\x1d\x9b9\xc1\x97\xb3MЁ0\xe1\x82O3\xbd\x92\x88\a\xf2\xdc\xea0\x9f\xa9\xf2oէ\x02\xc1\x924>k\x1ey\xb9y\xbd\x12Y\x166o\xc0\xeb@\xacʁy2m\xa5#\x9e \xa2Ћ\x12Z\u0530\t\x8eI\xef\xfd\b\x86\v?i꠸</\xd84\x9c\xd1oz\xb7\x8aP:\x16\xb5>\x96h`\x83\x1e\xfd\xc2\x7f\xab\aH_\xe8\x02\x13\xde'\t\xc5>\xe7\x03\xdf\xeb\x013\u05ee\xf9\x9f/\xa0\xe4$酊\x91>\x00\xef~\xa8\x91\x85\x9fL _d%\xbc\xc0Bjn\"\xf2cê\x85\xf7\x00\xeb\x99\xd4\x1f\x17\xa8\x00T\xecV\x0fGw\x0f\xa8ގ\x9d\x91\xe2\x80\xe8>z\xeb\xc9\xeb\xe8\t%\xac{\xf50\xc68\x02\x8c\x8a\x1bzŐ\xf0s\x87\xa9\az\xd6B\xb9s/\xf5\x80huX<a\x1f\xe1\xac*\xc5\x18\xcf\xc49\xfbI\xb1\x12\xe5=@\x8f\x1f`\xe1\x1e =K\xb5X\xf8\x83\xbd\x9e\xf5\v\x9f\xb8<\xe8\xce\xfb^\xdc\x1b\xa2\xdf\xfa\xe6R\x7fP\xc4mቫ\xae\xbf\x90\xee\x80\xecO\xf1\xe8\xe9\xf8§#\x87\xa9\x8cqx\x82CO\x13\xe7^\xaaXߛ/\xe3\xa7\xf8\xd1\x02\xf3\x17\xd4\b\xa2)\x97jn\xfa\xfb*x\x92T\xe4f\xbe\x84\xb3\xc2\xf3\xae\x1fP\xd4q5\x0f\x84\xeaĊ#\xdc\xcb\xd9.g@ \xe8-\xae\x83.g@ \xe4\xb6\xeb\xe07s\x06̗\x86\xbf\xcc\xe0\xd7\xcb%OnR\x11\x1d\xa8G\xbe{ws\xd1\x04دu\xf3=\rE\x03\xae\x01\x91\xf1x)\x8d\xa18\x85\x98bPm\x0f\x90'\xbe\xe0g.\xf3E1\x9dDzY˦\x1e\x1b97\xcf\x1cO\x8e\x81\x97\xd3\x1eߐ\n}\xb2\xabL\n\x81\x8e\xf1\xce\a\x8e\x8d\xf4\x00\x19\x95\xd8$\x82\xa32\xed\xd8'A\xb6\xd1}կ\x88\x9fZ\x03>\xa9\xd1\xd2&\xbd\xab\x1e3^\x1e$\xbf\x9e\xf8@\xc2\xf2\u008d9\xac\x9d_\xed4z\x00\xa5\xf3\xb3i@O\x8a\xea2(\xf4\x050\fe\xe3AA\xd2:\xc5\x13\f\x94u\x87\x97<\xb2K\xc5\xd3\x03pW\x88\x89>\xd3\f\x1c\xf5\x80\xdc\x15j\xaa+\xc5\xf0S\xdd7n\xda\x03\xf0nm\xc8\xfa\x8d\x01x\x1c\x8d\xf8(Z\xf1\xe9\xddV=^rM\x86\x0e\x9a\xa2rS\x83Q\xbb\xc2\xc1;\xba7D\xe6\xed1\xe4\x8b\xd5\x1a4\xd1\xc8N4AK\xe4\xff\xe2n\x10\x14\x9d)Ɂ2\x0e\xa8V\xae\xde]͍\x92\b!\x16\xdcy\x12\xef\x87C\xad].\x9a\xab\xc5\nC'\xae\xd5F\xb9\x9c\x95h\xf0\x96e&\\W\xb9\x10\x83\xf7\xbf\xe1\x14\xe1e\xa9\x8eo+u]~\b\xa8\xbc\r[\xa5\x1b\xb8\x05K\x17\xa2ӹ\rY,g3\xe1K\x8d\xa6\x02uG|)\xf2\xb0t`\x97\xf73\x15si\xeb?\xf4\x8cq\x88\xa1\xe3cS\xf57\n\xc1\x00U\x93Ȝ-\xe5|a\x19\x99q\x96h5g>\xf1\x06=.\x18\xc2\xf5\x01Pu\xc6\xeey\xb6d\x9cE<Z\b\x9c\x16W,.\xc0ތ\x9a\x84\xaf\xc7&\x0f\x8b{\xc23\xe9\xbcA8\x11\x16\xb5\x1b=\x04\x9e\x149\xf1\xa7\"\xe7>!\xd5\xe7\x95z\xab\xadΰ\x01p=4$\xac\xfe^\x1a\x12\x0ec\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠalЁc\x83L\x1eKu>\xeaEP[\xfa\xe6\x057\x8a\xf7=7\x90\xfcU )\x0f6\x99]\x99\x17B%\xf4\x00\xb0\xaeΫLl\xf4\xf9\x1eF\xe4g\x98[\x18\xdbz\x9a\x00\x88\xddK\xf2\x8dCР\x1bC\x1d\xc2jʤb\xaf߿)y\xa7Gÿ>\x1d\x8fh'\xefU$\x0e>\xfa\x8eʺQp\x02Y\x94hL\x82@\xc59\x16Ƣ\x05WJ$\xee\xfe\x11\x94\xdc\x03\xbf\xc4T\b\xc5t*PY<]3ΌT\xf3D0\x9e\xe7<ZL؏\v\xa1\u008f\xddub\xafVi\x90Ѳ\xb4ǟ\x89eX\x0f|,\x8f\xf1(\xd3ưe\x91\xe42-\x17Ȍ\xa0\x92\x1d\x13\x9a5\xec\x0f\x15D\x84\x8cxX\x84\xe8\x1cW\xed\x00_\r\n[\xeaz/^\xba\xa1\x9d\x01\x8eX\xa6\xf9\xbaL*\x16l&\xb3\xa0B\xd2(\x91t\x11\xa0\xfd\"\xb9\x00\x9d\xdeb\xa9\xce(=1G\x0e\xac\xc5h\x88.\xc1\xe6\xe8}\xd8Din(I\xb6\xb6H\xf7\xd1X\x1ag?\x9b\x90\x04:\xee\xfaÒ«0J\xa4\x1b\xd3g\xc3W\xec^\xae-\xb1ĵ4U\x06u\x88\x85\xe4\x85\x1dr]Kar\xc6x\xbb\x93X\x90\x97\x81\xd2\xc1*\xa1\xe9\xf6O\xa4\xaf\xc4\nU\xb5\"\x12r\x15\xa2\xa6\xf9\x16\xc9\xf7\xa8\x82/\x17\xd9R*J[~'\x8c\xe1sq\x1d\x14\xb6\xdav\xa1\x03\x94\x1a\x89\x04\x99\xf4H\x8c\x04\a\x94\xefVg\x854\xf2ڒ\x03\x80.\xed\xee\xcat\xfc\xfb\fÁH\x8cQWe\x8a\xd3\a\xd9\xf4\xad\x85ջ\xdb:d\xfa\xcf\x04\x80\x95\xe8˝\v\x85N\x1e6\x89`\x9aI1c3\xa9x\xe2r\b\xcf\xe0\x19\v\xa9\xaaG\x1fM4\x964\xb8\xeck\xe5S\xd4<V&\xec\xc7\xe0\xb2\xfa<+\x14\xac\x942\x19\x9d\xaa\xd5\xe5\x8c\xcd3\xe4\x82@\x17ržy\xfe\xd7?\a\x00\x9d\xaea\x93R\xce@\xaes\x9e\xf8\x05\xb2D\xa89(\xca*\b\x9e\x84x\xee\xcaC2\xe5\xe9\xd3\x1cB\x8b\xe0\x17_\xdfMK\xa6\v\x12\x01\x9a=\x8b\xc5\xeaY\x8d\x1eǉ\x9ewMx<\x1e=\xa2\v\xa1\x83\x85i`PO&\xf6m\\\xd9B\xdfӹ\xd6\xe0\xf7\xe07gѠ\xa0D\xa7E\x02\x82\x99\xb07e'\x87\xb0\xf69\xadj\xd8\xf6\xd6!w\x82\xd8\xd8/\xab)h|\xb2\xae\xdfF\xd0ީL\xce9\x99I\x13:v\x9b\xb07<I\xa6<\xba\xbb\xd5o\xf5ܼW\xaf\xb3,\xa8\xf5\xaa\xc7\x19-6\xe1&gѢPw\xc0E\xb5\xf4D\x87\xf8dt\x91\xa7E\xee+\x8cj\x87]\xee\x1dr-,\x01ޚC\xcet\xa9\xadL|\x96\x10\x18\x98\x82\x05y$\xb0\xfb\x10e\x0e\xb9\x90\xe8y\xb9fSg䯟\x7f\xf3\x17+@\x02 \xea\x8c\xfd\xe59\x15\x17\x983kϐ\xf6\x86\xc1\xb8\xe4I\"\xb2\xbe\xa2\x01$\xde%\n\x1eU\x12\xe4\xeb\x83\xef/_\xec\xeaz{\xfbw\xba\xb7\xca܈dvf[6:\xe7R\b.\x8fɴ:v\xba\x10W\x8e\xb6\x894yT\x1bi\xa5\x93\x02\rWV\xb2\xff8\xe1\x06\f_\r\x93H4\r\n\xb9\xd2L\x13\x1dݱ\u0601\xa9\xe5\x18:\x1d\\\x1e\xddd\xf4hy\x94[\xf7\xe5vLU\x99l\xc9\xd3t\x7f\xcaüb\xc1\x8c\xdf7\xb6I҂\xfaa\xf5\xd8\\\xff\b\x87\xc5q\x981܁\x9f\n\x8c?t\xa4\x85\x05Bd\xbe\x1eGϚ\xa7\\uZ\xb7\xdf\t\x86\xeb\xed!\x9c\x16\x99C!\xa8\xed)\xa5\xfa\xe7\x9760\xabJ\x1f\xfa\x92\xe7\xee\x9e\xd0+\x82D%\xaa\xa9Ȍ4\xb9P\xf9G\xa2\xe8\x97\t\x97K\xe7\xda\n\x86\x18\x1er\xea\x89\xc6>\xbe\xfaq\x8d\xb4\x83^\vDn/\xf7~x\xb6\xa5\x15\xac4\xba%\x80\xc3\x1b\x94\x84*m\v\x86\x1c/t\x1d\xc4\x1dL\a\x1e~ɖ\x1bw\xc1\x03\x8c\x80Ä\xf3\xc7\n7Mٌ\x1d\x862,\xb1\x89\x85\xf8\x1b\x89d:\x98\x83%2\x00\xf8\r4\x84i к\a\f\x9d\x9c,f\xaa\xeb\x8e\xf3*\xa0\xbduѣ\xa9\x1c<\xf3ni\xec\xf8\xfc8\x04\xbf\a\b\x14\x8f\xe4L\xa7|\xdec\xd8\xea\x06\xae7\x81\xb1\x18\r\x05\x96\xb0\xb6\x03\xc1\"\xe1\xe0\xde.\xce\xf6|H\x1dT\x11\x97]\xc0z\x804\xb9K\x1fp\xfa\xd4_Yl\x8b\x89\xfb\xe0\x9co\fC\xd3\x05\xe2v\xf0\xa9W\xe1\x95w\x1b\x88\xb8\xd2J\x84\x1b\x01Ƶ'C\x1b\x01[=\x00\xa3\x82\x1a\x04H\xc5^L^<\xff\xe7Qߴ\x87\r\xf5ݫ\xc5RM.=\xd9\xee\xfdȭ\x830\xf0ι\x1d\xab\x19Y\xb2\xdfd\x1b\x14d\xf0x\fW\xa3\xa3\\\x1a$~B\xdecdV\xd4\x1a\v\x9d\x86\xe2\x88\x1d:\x80\xafߝ\xcbEp\x8a\xe9\x17\x97\xf7V\xd3\aBdV\xc8ty\xa4M_\x88\x1d\xaa\xa2\x8e\xea\xa3\xf0\x0e\x97'v%ǆ\x86.\x9e>\x19;\xb8cz\xfd9\xcd\x0e:\xaaןSN~\xef\xb4yf\x810\xbdQ\xb8\xe3\xcc\xfaB\xec8\xb3\xbf\x89\x05_\xf5\xd0gF.e³d\x8dþ\xb1\x18d\xd3\"gB\xadd\xa6ղϨ\xd5\x15\xcf$&\x0f\xb2LP3\x1f8\x1b\xfet\xf2\xf1\xe2\x03e\x16\x9dBs\x06\xc3\x14\xfeT\n\x84\x8d[\xd4_[\xeea\xb2\xe5\xe8\xa8E\xc0\x1e/\xa0\xac`\xd8\xd0\xe5\x1e\xaf\xb0\x18\x96E^\xd8\xf9\xa4\x9f\xa3\xa40r%\x9e\x88A\xfa\xdd\xd2Jk\xf7\x0fpIs\rV^\xc9\x00\xf9А\f/k\x04\xd7\xea\xd6\x12r\x8c\x973k\x94y}x֝\xb2\x11$!\\\xc6i\x19\\\x82\x91\xe6\x9cɮm\xd5T\xf4\xeb;\xbeyE\xb1M\x03\x9f֭\x1cF\xbd\x01\x14\x18H{!T\xe7r\x04\xcfG\x81dvk\xdfs=\xbc\xad\xbfn\xc9?S>='\x86\xdc\x03\"C4\x06+`\x1fE\"2\xed\x95\xc6=\x97yY\x99 \x95\xccK\xa2ޏ\xd8\xe8\xa2b[\xd5MF_\xf4\xa0\xf7<\x89\xbd\x1e{\xe8\x98v\x93\xd3\x0e\xf2y\xe0\xebۿ\xbb\xf5E\x19\x8be\xaas\xa1\xa2\xf5\xad\xbe\x13\xad\xabn\x834.7\x1e\xc6͋#\xab\n\xa6\vO\\2\xcd\xd8\x14e\xdf뻎\xb0\xbf\xa4\x9e\xaf\xb35\xe4\x17\xa5\t\xa2+\xa0\xcejm\x15.g\x8c+2\xe2˿\xc1x%\xa21]\xb8ɱt\xc6\x13\xdc\x01\xd0\x00J\x9a\x1c+\x8bY,\xd1\x15(\xa7X\x7fm@\xe3Y\xf3\xc3\xf8k\x9b\xfej\xf3\x1c]\xf7\v\x10uVPS?\xdb\x0e\n\x9e\xe7L\xe4\x19\x9a|G\x14\xf2\xa7~I\x86ź\xb3@\xc2\x7f\xd0o\x84!\x0eZ\xa4,\xbf\x97Q\x8b\xa0\xb7\x12\xafTQR\xc4\xe2eR\x98\\d\x1f\x84\xd1E\xd6\x11\x95i\x1e]\xf7;\xa5\x120\xecޅ\xbf`\x17\xe4\"\x1b\x9bH\xa7\x1d\x82:\xab^-\xed@\xb7\xa0\xd8\x17\x83\xc2O\x9fy̹\x8e\x19\xb4\xf1\xce\xe45U$\xc9F\xc9\x02\x02\\\x1b\xcf\xe1)Xu\x9d\xd9\xdc\xdboW~i\xb8V\x9b\x94\uf266\xda\xe3D\xe3\xcc$\x88\xc2\xe8\x19\xb1&\xc1\xb1\xff\x85պOl\x80e\x8e\xdbln\x146n#\xc2\b\x02&\x15\x18_\xe3H Z*l\x8b\xebs\x87X\xdb\x03Mm\xf9\xe0?\x1fDJ\xd5\xd3\x1b(\xf2\x14\xf20\x86\xda\xc4Q\xc7QEi\xee9\xc7,\xbf\x03\x84\xd1Ĭ\x1b\x91\x90\xed\xb5\x13Yo\xebOZDa\xb2\xe6\xeaŤ\xf9\x17\xf8\x15d\x82\x94!H\xbaQg\aP+= 6їv%\xe3\x82'\r*\xaba\xa9B&\x9c\x1fJ&m\x87\nO\xaa\xb7\x1b8e>\x85m\x12\x82\xab]\x1em\x8aN\xe1\x02\xe3\x92X\xdbOl\xa0m\xf3\x05\x8b9\x17+vC\xb9\x8cǝS\xa7\xb8,n)7\xbd]\x88\xc6S\xc4t\x17W\xaf\xba\x8d\xc6-D\xd4Z\xe4Ŏ\x858\x9e\xf0\x7f\xa1\x18\xa53a\xb7Y:T\xdd`\x90\x96y'\xd66镔+:\xaaz\x104\xd3\xc75\u07ba\x136\xbdľ7\x19\xf5\v3܉\x1d\x1e\xbc\xc6v\xf1=\x1f\xb4\xa7}\xe3\x17e\xf0\xb5D\x82\x1dz\xb1m\x93\xf8\xd9\x15a\xdd\xc1\xa9\xfe\xc7cd\xcfe\x97\b\xcc\x04\xe8\xcf\x1e?\xbb\x13kܰ\x81N\xd0\xd7B\xa6\x10T\xbb\xda\xe7\"yZ\xcf<\xb6\xcb\x01:\x16\xb8\xe5\xa0KuƮt\x8e\xff{]\xda ;@\xbe\xd2\xc2\\霞=\b%vQ{\"\xc4>\xecl7R\x06\xe0)\v\xbf\xdc\x1e\xa5\f\x8br\x7f[!\x93G\xfeRAȸ\x9d\x97\ŕ\x13\x00\xf75^\xe8\xceH\xe2\xddC\xdf\x01\xd4\x7f\x17\xd0\x1d*u\xd6\xc0ז\x0f\xed\x809\x15\xcc}\x9e\xfc\xeevqd\x05\xa6\t\x8fD\xec[\x1fs(\n\x9e\x8b\xb9\x8c\xd8Rd;G\xa2\xa7\x90Sۏn\x87$\xd9\xfbl\xb7k!\xff\xbf\x87\xae\x13w\xa2\xfb\xbd\xf1\xee\xe3\xed}\xd9p\xf2\x9e\x14\\\xe7\xeey컨^? \x9f\x1e\xc0O\x83\xaek\x1fu\x8a\x96\xa7\xa0\xec\xff\x838%B\xf9\x7f\x96r\x99\x99\t\xbbp\xd5\x1f\x9d߬?\xef,\x8f:\xe8%O\x01\x1e8_\xf1\x04\xa2\x1e\x82C1\x91\x88\xad\xeeJ=k\xa9@8GP\xe0\x02!Z\x86\xb1\x8e\xee\xc4\xfa\xe8\xac\xc1yے\x0e\x8f.\xd5QY\x19\xd1\xe4\x03\xafglK\xe7#\xfa\xdbѤ\xa5\x04;\xc1\xeeT\x8c;(b\xeb\x9fJK\xf7\x9dM\x86:\x1f\xf5\xa1\x85\x1dtР\x81\xab\x8d\xaf5\b\xa1n\x966L\xf8\xf6\xe7x6\x17yǓ\xdeV\xa5Ԉ\t\xbbP\xeb\x16\xd4\xee\xd2xo\\U\x14\x95\x96\xbe2\a\xd3&\xdf\xd7\x01\xb9T'\xba)\xe2ד}\x91\x0e*\x13\xd9J\\\xe9X\\\xeb,7绐v\xbd\xf9tǭ\xb0\xb6u\x9d\xe0N\xed\x1e\x1duƈ\x9c\r\x1ab>n\xbf\xc2\xf9{\xc0;\x1d#|\x97\xed\xdėͧk\x9b\xc9\x17\xb5\xa0\x00\xe9{\xf6\x12\xc3\xd9\xe6\xefx\xbb\x85\xa9;(\xb7\xebcS\x1d\x8cg0(\x8b\xff\xb8y\x7fe\xb5\x00\xe0k\x1a\xa0\xbbv\x84B\x84\xd1f3\xd7\x19'_\xc0~˪\xe3\x0fB\xd7.Î\xa7\xf2\xbbL\x17i\xfb/\x1b\xb8\xba\xb8\xbe\xa4\a\xbdY7\xa7\x7fx\xf7\x9c\xdf\x01\x9b\n\xec\xb4D\\\xa7h \xafr\x1d^\x87\x87\xb9\xfc'\xfb\x1e\x83\r\xbd]\xb0#\xbe\x15\xc1Yrq}iW6ao`_\xaa\xb5KM\xc8\x172\x8b\xc7)\xcf\xf25i#s\xd6X\x81W\x8b\x93Q\xa0^\xc1\xd8\xc5\aqG[px\x03\xb4\xc6\xcdw\x13c\xa1+ؖY\xd0X\x01d\xdd\xe6h\xa5/\xb4\x02\x8f\xba\xcd5\x8c\t7\xa3=\xfc\x95[E\x93\xa3\xf6\xeb\x8f\x0f\xb2\xb1{l\xb70\xc2%\xb6\x94\xad\xd7\x1f\xdb\xdc\x06\xef\v3\x8a\xa7f\x81F\xf4+\xc9]ݟ.b7\xf6#;\rb\xbd\xed\x92\xcaD\v\x11\x17\x89\xe8\x9a\f\xd5\xd8\xddM\xedA\x7f\x84\x85\x92\xffS4\x87dy߹{z\x03\"\xab\xe3\xa1t2\x95Lf\x8d\x83\xbf\x91$\xf6\xdfq\xde\x15\a\x17\xfa\xa7\x05\xb3\x0e\x900\xb5D\xbfcL\rRy\xadi\x90\x13\xf1\xce\xebY\xe6\x1fIS\xaev2ڋܺHm\xec\xa0o\xe4\xc2tҔ\xadQ9\x1fm\xc1\xb4\xa3\xa3\x1bz\x8aE<\xc5\b\x117\x87\xa1\xc8h\xd4KՒ\x9e{\x8c;$\x8c\x1e\x96\xb7.\x1a!\xb5B\xdc\xc4\xe4|\x99\xee<\xf9\x97\xed\xe7Q&\xa9\xb3؉\x12\xc4Ljz\xc7ف]uG\xf7\xbc\x9a\xdb\x13Oj\x90m5*\xddl\"\x9d!j-V(~V\xae]\x93\x87\xbdyB\xcc\xcd\xf7F\x8b\xcccSBA(\x8f<\xbb4i\xa5\\\xb6\x19u76@,n\xdcQ\xf2\xbd\aOu\xc8\"*\x8f1;QJ\xf5C\xceC\x14!>EG\x99$\xf6]_\xc1\x03\xf4\"YQd\x82ͅ\x82q\xdd!\x15\xdd\x15\x10#$\n@\xf7\x9c\xe81F\x18\xe2\x11\x82\xe8\x16<ln\xc1J\xfb\xadK\xe2\xe1\a\x0f\xa0\xc6p\xb4oK\aW-\xf5Ap\xa3\xd5\xce\xed\xbf\xa9?\xe9n\xf5\xb44\xe7t\xe2t~n0\x9c\xac\xec\x8d\r\x98$M\xf0\xd5ɾG\x93.\xb8\xd9-\xe6\xae\xf1\x84\x97ouv+%\x9cc\xcf\r B\x15\xcbM\xc0cv%\xee[\xbf\xc3\xe6E\xfc\xb1\x8cԴ\x1e\xb8Tי\x9eg\xed\x0e\x84c\xcf0-*\x18\xb3k\x9e\xa1\xd5b\xb2~\xd35o`\xcc:\x7f\xbd\x15O\xa6\xc16;\x11\xd6\xe4\xb0=\x05\x03\xbb\xe7f\xd49\n\xcdO;\xff}\xb1t\x15W{\xfd0sWG[g\xf32\xd6\x00\xf5_\x8b\xd39\x96<\x91\xed(\x13\fs\x19a\xb5\xa7\xa3\xbd\x9c4[\u05ff\u05fe\xdb~\x91{\x9e!p\xb8{\xbb?\xba\x87:\xa4\x99{\xff\xf1\xe4\x99_`S\xa2\xb5@Z\t\x17*\xd1:t\xf7ƯV\xa8>\x01\x0eV/\xaa\x7f\x11\xb6l@\xdc\xfd\x01\x8e\xd8l%\xe2\x1a\xee\xddR\xdco*\x83\xc0\xb6|p\xb1\xbf\xf3Qi\xda\xfb\xb4\xc24)2\xd4\xe9\xd3?#\xad\xac#\u009c\xb3O?\x8f\x98\xc3\xc0G\xbf\x0e\xf6\xe9\xe7\xd1?\x06\x00\x050\xfe\u070e\xc0\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko\xdc<\x92w\xfd\x8a\x82\xf7\x90]\xc0-\x7f\xc1\\\x16}\xcb\xe7\xe4\xc3x7\x93\x04\x89'\x97\xc1\x1c\xd8Ru7\xd7\x12\xa9\x8f\xa4\xda\xf1\f\xe6\xbf/\x8a\x0f\xbdZ\x0f\xaac\x033\x03\xb7r\x88)\xb2X\xac7\xc9R%\x9b\xcd&a\x15\xff\x8eJs)\xb6\xc0*\x8e?\f\n\xfaK\xa7\x0f\xff\xadS.oNowh\xd8\xdb䁋|\v\xb7\xb56\xb2\xfc\x8aZ\xd6*\xc3\xf7\xb8\xe7\x82\x1b.ER\xa2a93l\x9b\x000!\xa4aԬ\xe9O\x80L\n\xa3dQ\xa0\xda\x1cP\xa4\x0f\xf5\x0ew5/rTv\x860\xff\xe9\x97\xf4\x0f\xe9/\t@\xa6\xd0\x0e\xbf\xe7%j\xc3\xcaj\v\xa2.\x8a\x04@\xb0\x12\xb7\xa0\xb3#\xe6u\x81:=a\x81J\xa6\\&\xba\u008cfcyn1b\xc5\x17ŅAu+\x8b\xbat\x98l\xe0\x7f\xbe}\xfe\xf4\x85\x99\xe3\x16Rm\x98\xa9uZ\x1d\x99F\x8be\x8e:S\xbc\xa2\xc1[\xf8\xe6\xa7\x00\xd7\rt\x9d\x1d\x81i\xf8\x84\x8f7\x1f\x04\xdb\x15\x98\xdbA\x0e\xa1o\xb6\x93m0O\x15ah\x14\x17\x87\xb3)+\xccҀ\xfc\xf9\x9c\xb7J\n\xc0\x1f\x95BM\x04\x81ܒW\x1c\xe0\xf1\x88\x02\x8c\x04U\v0G\x84\x1d\xcb\x1e\xea\xaa;\x7f\x17\xe6\"\x06\x06˪`\x06Sc\x8as,\xfe(\x1f\xa1\x90\xe2ЙI\x83>ʺ\xc8a\x87\xa0\xd00.0\x87\xbdT\x1d\f~\xb5\x1d\xe1\xfe\xfe\xe32\x0e\x96Xi\xc1\xb4\xf9\xb5]H\x0f\x87\x8fL\x1b0\xbcD`\x1e\x05xdڮ\x7f/\x15\x98#\u05cd\x10t\x90\xb0\xc3:0\x1d%rfp\x88C\x10\xd7\xf4L\xd4:\xe0\xde\x1d\xf0\x1c\xccAɺ\xdaB+xN(\xbd\xa4;-鱣\xe0\xda\xfco\xaf\xf9#\xd7ƾ\xaa\x8aZ\xb1\xa2#϶Usq\xa8\v\xa6\xda\xf6\x04\x80\x84\x02\xd5\t\xff,\x1e\x84|\x14\xbfq,r\xbd\x85=+\xac\xf4\xeaL\x12\x8e\x9fX\x89\xbab\x99\x15N]\xef\x94WT\xbd\x85\xbf\xff#\x018\xb1\x82\xe7V\xb5\x1c\xba\xb2B\xf1\xee\xcb\xdd\xf7?\x10ƥU\xde3^\x04\xac\x81k`\xf0ݮ\x1b\x02`0Gf@\xa1EO\x18\xeaQ)\xdc\x04\xc4s\xf0BB\xff*T\\\xe6<\v\xb2b\x87v\x04\xab\x16\xa9\xef[)Y\xa12<P\x95\x9e\x8e\xa1j\xda\x06\x98\xbe\xa1\xa5\xb8>NwP[!>\xb96\xcc-AK\x06r\xefD\xa8\xc1ے\xa4\x03\x16\xa8\v\x13 w\xff\x87\x99I\xe1\x1b\x91^5j\x90IqBE\xeb\xce\xe4A\xf0\xbf5\x905i)MI\xea\xa5M\x0f\xa25F\x82\x15Ą\x1a\xaf\x81\x89\x1cJ\xf6\x04\ni\x0e\xa8E\a\x9a\xed\xa2S\xf8\x93T\b\\\xec\xe5\x16\x8e\xc6Tz{ss\xe0&\x98\xe6L\x96e-\xb8y\xba\xb1\x06\x96\xefj#\x95\xbe\xc9\xf1\x84ō\xe6\x87\rSّ\x1b\xccL\xad\xf0\x86U|c\x11\x17\xb4X\x9d\x96\xf9\x7f4\xe2\xf1\xa6\x83\xe9@um\x9b\x93\xebI\xba\x93x;\xf1p\xc3\xdc\x12[\xf2roM\xbe~\xf8v\xdf\x15\x1d\xae; \xc1S\xbb\x1d\xa6[\xc2\x13\xa1\xb8أ\xd7\xfd\xbd\x92\xa5\x85\x88\"\xaf$\x17\xc6\xfe\x91\x15\x1cE\x9f\xe8\xbaޕ\xdc\x10\xa7\x7f\xafQ\x1b\xe2O\n\xb7\xd6A\x91\xcc\xd5\x15iu\x9e\u009d\x80[Vbq\xcb4\xbe8ى\xc2zC$]&|ׯ\x86\x9f\xeb\xe8\xa8\xd54\a\xff7ʡ\xa0\xc3\xdf*\xccz\xaaA\xa3\xf8\x9egV\x01Ȥ\xb7*\xde1>\x00\xd3zIO\xe8\xdao\x9d\xc0\xc1\tJ\xac\xaf\x1b@\x04o<Ҥ\xd78N;z\x82\xaf\x9bE\xed\xdew\"\xd4H\x90\xf2&\xae!;@-\xc1dIo\xa9@\x8ecW)y\xe29\xe6cԛ\xa3 =9\xeeY]\x98\xef\x14\xaf\xa0\xbe\x97_Q\x1b\xde\xe3\xe9(\xf2\xefG\x87\x05\u03a2&\x8a\x9a#*R<\xfb\xc2Z\xdc\x11\xa8@k\xab5\xe6\xb4L\xc3\x1e:Η\xacaQ@%s89\xf4`\xf7\x14\x10\x1e\xf2\xa2\xe5\xc7N\xca\x02\x998{\x8f?\xb2\xa2\xce1oܕ^\\凳!6\xaad\\\x904\x91\x8f%V\x89\xf6-y\x97\x11\xa0\x00L!\x90\xfas\xe1 \x02\xef\x06Uc\x8b\xe1\x06\xcbQ\fg\xe4\xce\xfd\xa3\xa8\x95b\xc5-\x18Uc25\x9e)Ş&\xa9\x14\xa2\xedx\"5#\xbcQ.x\x86D\x9e\xc6\xf4Z:\xfd\x1b\x90\xe8(\xe5\xc32Y\xfeH\xbdZ\xb7\x02\x99\xdd\xc4\xc0\x0e\x8f\xecĥ\xd2\xc3H\x04\x7f`V\x1b\x1f\xe0\x0f\x1ff \xe7\xfb=*\x14\x06\xec\xe6A\a#1M\x9e9\xb5\xa7'0f\xe2\xf5`=-{\x89Q\x96\x06SK \xe5?\u05ff\xf0#\x84\xc97\xd7\x15p\x91\xf3\x13\xcfkV\x00\x17\xda0A\xe0I\xed\x1b\xdc\xc6ֵ\xc0\xfa3̝\x19\r\xf8\x13_z\x1eI\n\x04\xa9\xa0\xa4\xa8缫NF\xc0\xfbgj\xf9;F\xf6\xcc\x19kP\xb4e\xf4\x93\xd9\xfdK\xc7^\\\xcf\x00o\xb8ザ\x82\xed\xb0\x00\x8d\x05fF\xaa)\xb2,3}\x8d-\x9c\xa0\xe7\x88Ul\xed>\x89d\xbb\xc0Y\xa0@&\xff\xf1ȳ\xa3\x8b\xafH\xa6\xac\a\x81\\\xa2\xb6\xe6\x92UU\xf14\xbd\xd8\bI\x882\a+\fC\x9c\x898\xa7t\x90\xa9K\b\u074c\xed\xf8W\xa2s#\"\xafd\xe6b(\x93+\xe8|w6\xf8\xb9\x05\x9a\b\xccQ\xa7p\xb7\a,+\xf3t\r܄\xd6e\x98\xac(:8\xfc[0\xea\x12}\xb8\x1b\x8e}f}x\x06.5(\xfcK3\xc9:\x9bo\xde\u05ec`\xd0\xc7\xee\xb8k\xe0\xfb\x86A\xf95\xecyahW=\xb6\x83\xe9\xff\x1a\".r\xea\xb9\xc8\x12\xe75\xe9)\x99Ɏ\x1f\x9a-\xe4b\xff\x01\x85\x86Áww\x12}'\xbf\b\x99(\xf5{\xcd\x15\x96\xee\xdc\xe2\xfe\x88\xbd\x16\xbb\xebx\xf7\xe9=\xe6\xf3\xd2\x18-\x91g\xcby7@\xb9;\xbd\xdf\x06\xc4/\xc6\aT\xcd\x0e˞\xe7\xe8k`\xf0\x80O.\n\xa2ӱ\n\x15\xa3\xa9&7\x12\xc3G!\xedŭ\xe0\x11$\vȟuE\x8c\x8f\x17\r\x7fh\x85Oq\x1d\a\xa4$\xcc\xfcI\x80\xa3)5\xd0\x1am\xd3\n\x99\xf0;\x06\xa7!t\xf4\x149&\xda܄'p\xe2\xa2\xe56ll\x0f\xde\x1c\xa3\xdfйYa\x8f\x86\xf4\x91W\x91\xb0\x9d\x01\x06\x8dV\x8f\xc2I\xe6w:yn\xf0t;\x97;q\x9dD\x82\x84O\xd2܉k\xf8\xf0\x83\xd3)\x1e\xc9\xcd{\x89\xfa\x934\xb6\xe5\xc5\b\xebп\x88\xacn\xa8U=\xe1\xcc<ѣ{@\x1a%\xf4\xee\xdf\xdd\xde\xca^\xc3*\xae\xe9\xc8R\xaa@\x17z\xe9&\x8c\x06\xe9P*kmh\xc3(\xa4\xd8XG\x9b\x8e\xcc\x15\rӳG\xaa\x1ew\xba\xe8yJд\xd1PiC\xe7P\xbb\xa7X\xceA\xe0$\x9cUAw\x1d\x90ז\xa8,\x1a\xa26\x8a\x19<\xf0\fJT\a\x84\x8a|A,7\xa2\xed\xf3\x852\x17\x1b\x1a\x84\x9f7\xf4\xbd\xf3\xf9\xa9gCz\x1d\xd5/\xb0?\xa2\xf3\xe8y\xf4ϯ\xcd:h\x1b\xc7DP\xbb{\xed\xbb\xc6K\xac\xe2NO\xbf;\xe8Y%\x87\x92U\xa4\xe1\x7f'\x17i\x85\xfd\x1fP1\xae\xa2\xb4\xfc\x9d\xbd\xf5+\xb07ڟ\xbau'\xa29\xb8\x06\xe2\xf8\x89\x15\xc3ێ\xf1\x1f\x99c\x01X\xd8\u06040\x1cF>\xd7\xf0x\x94\x1aI4`O\x17\x8b\x11@\xb9\x86\xab\a|\xba\xba>\xb3KWw\xe2ʅ\bC\xad\x8f\x00\xdbD\x1cR\x14OpeG_\xfd\\8\x15-\x9d\x91\x1di\xf7\xb7M\xa2ń\xb6\xc1!\x9a\xa0\xa1\xcd\xe5#mI\xd3\xe4\x19d\xb3\x92ڬ@\xe8\x8b\xd4\xc6\x1e\xa7\xf5\x03\xdeu\xe7m^\xae\xfc9\x1b\xb0\xbdA\x05\xdaH\x15\xae\xfa\xc8H\x0e\x8e\x8d\x89\x8b>\xd5b\xfaa\xaasz\xe7\xc0Җ\xfb\xaa\xd5ow\xfeq\xe5\xee\x00\xe9\xffK\x103\x1aGn\x03\xe9H.C\xad\x97\xc4&\xca\xc2\xf7\x88zN\xbd\xe6P\x93\xb9\xcd\x12\x1d7.;\xa8\xb0\xdfJ\x93\xe7\v\x85\x89\x9c˽\x06\v\xfa\xf0\xa3s.\xcb(-\x05\xb3\b\x91]\x8f\x1d=t\xa3\xca\xfa\x17\xccш\u07ba\xb1A\xc5<(k\x7f\x98:\xd4d\xf3\xe2\xe3\x97V\xa4\xffy\x82\x81\x92\x8b;+\x8f\xf0\xf6E\xc2\a\b\x17ix\xd9\xf6\xe16\x8cnY\xd04\x8c_\x92N\xfd\xe8z\xf1\xf1\x88\n{\x9c<?Տ\xe5\x8d\r\x9b\xe9P\xb5s\xf4A\x90+\x99\xbfѰ\xe7J7[\\\x8c\xdf\xceq\r\xf5\xa2\x05\xf9\t\x8eK\xf1A\xa9\v\xb7r\x9f\xdd\xd8f\xc1t\xf0\xf9\xd8\\\xe8O_\xfc\x8e\xfd\xec\xf5\x18\xd2\xc9\x117\x80\"\x935%\xb0\xd8\xdd\f\xdaI\x1c;\xe2\x05\x19b\xfd^\xfb\xa0\xa8\xcbXBl\xac$r\xb1p\xbe\xd4>\x1b\xf8\x8d\xf1\xe2\xa5\xd8H\xd9k\xb26ۨ\xce\x036R2\x9a\xacMc\x7fIhK\xf6\x83\x97u\t\xac$FDB\x05\xf2\xec\x84I_\x06\xe0\x91qc/\xc0\b2Yu02\x1ad&˪@\x83\xb0\xc3=\xdd\xd4eRh\x9ec\xe3\xfa\xbd\\\f\x12\xaa\xe6\x1e\x06{ƋZa\xfa2\xdcX\xb7C\xf2\x86'\xa2oth\x19\x8f\xc2\xc6:\xa0\xe4\x99\xe6\x8d\xf3\x04\x95Z\x13\xd0~Q\xf8\xdc\xe1c\xa58ɢ\\\x8a \x17 \xda\xf8\xb2\x1fAz\x11e\xe2i*\x84\\\x80I\xfe\xfd5\x84|\r!_C\xc8\xd7\x10\xf25\x84|\r!_C\xc8\xd7\x10\xf25\x84\x1c\x84\x90˘ml\xd2L\xf2\x13\xd8D\xa5\x10\xcc#;;\x8bφ\xb9-jmP\x850l\xd4/\x8fe\xc2\fǍ\xe4_g\xae\xcb\xc6~\xab\x93's\xb1[\xf7\x83\xab\x90\xa6c\xf7kAQ\xec\xa5\xecrt\xbcH\xb4\xf9<m~\x96\x8d\xb5M\xd6'p\xf5s\x90\x9b䩐\x84<n5\xfcԞ[ڞ\xf6v\xb3\x81\xfayX62\x0fئɪ\x18k\xc1\x10D\x92p\\\xe6\x02J\xab\xc5):\x85[\x869F\x00\xc3@@\x06\xe4k\x85ퟔz\x8b\xb9O\xd3\x19O\x8ej\xf4\xf1\xcc\xe9m\xda\x7fc\xa4\xcf\x7f\x82Gn\x8e#P\x814V\x00m\x17š\x9b\x18\x1dd\xd1\xc8Q\xaaR\xea\xb2\xe0\xc5xN\x03+\xda\xf1=r\xc3g\x8b?+\xd2Kȷ\xb4M\x1a^\xf5\x8d\xf7\x1aPr8h.3*x%{Ξ&3[\xf3\x95\x17x32\xf7\x13\xb9OK\xa9Jk2\x9e\xba\xd9L3 c\xf3\x9c\xe2v\xbc\x8b9M\x17d2\x85\f\xa5Y\xb8\xb0\x98\xbf\xb4`\n\xc2\x13h\xb8b\x19ϔ\xa1\xb4\"/\xa9\x9fo\xb4\x00w]6R$\x99b2\x8fzD\x8a\xc97\xf2\xb9=I\\6\xd9L\x96\xd1d\xf6P\xb2:\x8fi9gh\x01f\x1f\x95g\xc9\x14\xba ?h\xc1^\xad\xe2\xfd\xbc[\f\xbf\x98\xa8{.\xdb'\"\xc7'\"._´\x93\xbd2\x85\xe8\xbaܝ\b\x1a\xf6\xf4\">O\xa7\xc9\u0099\x9c{mvN?\xf7f\x12lLN\xceD\xc6\xcd$\xcc\xd9L\x9c\xd8<\x9bI\xe8\x8b\xee{Arf_KՋ\xd8Fe\xa1\xc7\xe2σ\x01\xbd\x80ed0\xc4D\x86\x13Q\xe0(\xb8\x89\xc8\xf0\xb3o\xb6\"Hg\x1bt\x0f!\xf7=\xe7;N\xe3~\x88\xd8G\x93\xdc\xcdي3&\xde\x18\xd8ɉ\x88v\xd7|\xea=β\x19\xeb5\x1fi9J۶\xdfkTO O\xa8\x1a7\x9b\xcc_Q4\x89\xf2\xba.\xec-RW\x99h\x99C\xadH\x96\xa4\x11\xde\t\xb7C\x1c\xe2i!\xa1\xee\xc6\xe1)\xbc\x9b\x80Ga\xf7\x14\x00!\x9b\xf1\xc9ea\xdcpQS\xfd\x06\xa4\x1f\x0e\xeb\t\xf9\x10ۮ\x12\xa7\xc9\xec\x95Y\xafo\x87\x94\xc9Şn^b.\x8e\xcd_$:\x8f\x8f\xcfc#\xf4\xa8\xef\x0ez$z\xd6(}9N\x8fr\xa1ޮy\x8a\xaeZ\xce3E\xeb/\x17\xaf\xaf\x8d\xd8W\x10,\xee{\x81\x1e\xb9\x9e/n\x7f\xd1\xc8\xfdeb\xf7\x17\x89\xde/\xcc\xef_\xb4k+ea96\x8e\x8d\xe3\x97\xf3\xf6\xa3\xf2\xf5\x17b\xb2X\x9c;Nz\x1a\xe5u1}$U{z\xf3\x9cq\xfd\x8bE\xf6/\x13ۿtt\x1f\x11\xdfGH\xd3B\x87\x9f:\x1a\x96*G\xb5p\xae\x1e/\x82\v\xc2\xd7\x13\xbbσ\x99;\x17=m\xe8\xef\xf0\xeb\x9e\u05cf\x13[6\x9f\xe5f@E\xb4\\<L\x1fytb\x02za/K\xda0\x85\xf8?n\x05C<8\xb8'\xd0X12\x899\xd5\xed\xb1\xb7\x93:\x85\x0f,;\xf6;\x8e\x82<2MwO%3p\xd5\\\xb9܄q\xd4r\x95\x02\xfc&\x9b\x1b\xae\x06\xa6\xbe\x06\xcd˪\x18\xb7$\xb5F\xb8ꃹ\\L&\xc4\xccU+\xa4\xba\x80\xdb%\xc6~m\xba\x06\u008b\xbaܡ\"j\x96R\xdbjoS\xaa\xae댒\xc2\xf6u\xe1\x05\xa0\xa9\t\x13\xea@\xf9\x0fj\xa9\xda \x15X\"\xff֩\xe28|\x8c\x84\a\xc4\xea\x1a\x14\x1e\x98\xca\v\xd4\x01 WTc1\x85;\xe3\x94\xdb\a\x85d\x8e\xfc̣\x00\xc3Ļ'`\rN\xf6>\xedo\xa8\xe4u\x18k\xf5\x9e\xe0\x8e\x0290\xb5c\a\xdcdT\xca3\x1b\x96W\U0005834c,\xb9\xa0\xd4\xd5-\xfc2\xf2ұ\x8f\x8a\xe3\x1dF2h\xb4`\x95>J\xf3'y\xc2\xf7\x832h\xa3\\\xfc6\x180r\x0f\x1b\x12\x8choDTe\xc5x\x06GEe\x03\xb5\xa1}\x8a+z\x05Y\xc1x\xa9\xc1f\xe0\xd2\xea\x9f,\xdd0\xdf\xd4\x15e\x04uom3Y\xf1\x89\nB\xbdC\x02\x9b\xf2\xc8\x0e\b\x85\xf4E\xd8\xecك\xaf\xd5E\xa5x\x90儥a\x0ft\x82 \x98\xe1\xa7q=\xf5H\x06\x92\x91\xf2\xc9v\x1b\xe1\xa7˘ \xf4\b\xbeT\xb6\xb2\x13\x9dz(\xd4\xc7\t\xa6ۊf\xbe|b\xa8\xfdŅ=\xc9\xf0\xea~\xedO*\b=[\xc1G\x00\xeb\xd4H\xca\nY\x8f\x13\xc2\x17KS?\xa1\xf6S7ׁ\b\xbe*Z\xb4\xd8\xf8\xfecR\xe3k\xa2\xd9\xe54\xf0'\xcd:\xd1\xe7\xcbw\xfb\x05\xb5\xa5C\xd6V\xd1\xf2;\x1a\x7f\x8a\xd0\x1c\xf3\x84\xd7\xe3\x05\ue783&N\xd2>zA[\xa6I\xbf\xbf߬ۛ\xde\x10a\x84|\x1d\xffa\xdb\bD\xca\xccq+\x1a\x82k\xbf\xf4\b\x9a\xd0(\x8f\x93\xcd4Y驍)\x16\x17u\x7f\xff\xd1-\x84R\x9a\xd2\xf7\xb5\xb2\xc8l*\xa64\x12m\xc3\x02ݠ\xdd\xd84\xf4\x1c\xbbEr\x7f\x1d\xe2߭\x91\xbbz\x15Nɂ@\x06r\xe9ŕ}\x1f\x1f\xd79&\xea0\x8d\x186)\xbbS\x90\x98\xd62\xe3ցY#\xd5\xf1bi\xb2j?5K\x80\xb9\xc8o\xd2\xd9\xd7\x1a??\nJ\xdc\xf1\xea\xa6\xef\x84\xe3\xcb6\x99!ڟφ\x05f\x8e\x19\x00\x8aX\x06\xdd\a\xc0\x81\nC\x06g\xdaq\xba\x96T\xa1\x02f\x9a\xac\xd0\xeb)\x9d\x1e\xdb5n\xc6\xcaNn\x9a\x1a\x98\xc9\x02\x1d]\xa9\xebm2A\xab\x80\xbe+v\r\x19\xabL\xad|؛\xd5\xca\x1a{\x02a\xf3T.)#\xdaV\x84\x9e\xe5\xd9Ǧ[\bԊN\xb9\xe8_'\xcaE\a\xec\a\x90\xdb⥃\x17.\xe2ue\x9f7d,\xd63mD\xbem\xc1\xc0\xd9\xd5}\xa1\x1e\xc0\xfbd\xb5\xc3BH9\xb1\x92\xb1D\xd7\r\xd5+?k\xeb\xd6/o\x7f.\x97\x15\xf3\xefM\x8d\xe8\xd8E\xb5U\xa5\xed\xd7gzv}-x\xd7y\x90\xdfDy2-<\x97&\xac\xe1?\xf9>\x19-\xab\x92\xd1J\xfe+\x892<\x93\xf8O\x19\x9c\x11%\x194\xf9\xca\xd2[8\xbdm\xff\xf2\xa5\xe6\xc9\xc4\xfa\x17\x00\x9a\nH\xe7\x1dY\xf1\xceط\xb4\x9aǲ\f+\xe3\xf3\xe7\xba5ů\xaez%\xc3ퟙ\x14n\x8b\xab\xb7\xf0\x97\xbfR\xc9o\xeb8}\rl\xbd\x85\xbf\xfc5\xf9\xff\x01\x00\x10\x83F\xf8\xe6_\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe36\f\xbe\xfb)\x88\xeda/\xb5\xb3\x8b\xbd\x14\xbe\x15i\vl\x7f\xb6\x83\xc9v.\x8b=(2\x9d\xa8#K.)e\x9a\x16}\xf7\x82\x92\x9d8\x8eә\x16\xe8d.\x96H~\xe4\xc7\x1f\xb1(˲P\xbdy@b\xe3]\r\xaa7\xf8{@'_\\=~ŕ\xf1\xab\xc3\xdb-\x06\xf5\xb6x4\xae\xa9a\x1d9\xf8\xee\x1e\xd9G\xd2\xf8\r\xb6ƙ`\xbc+:\f\xaaQA\xd5\x05\x80r\xce\a%\xc7,\x9f\x00ڻ@\xdeZ\xa4r\x87\xaez\x8c[\xdcFc\x1b\xa4\x840\xe2\x1f\xdeT\xef\xaa7\x05\x80&L\xea\x1fM\x87\x1cT\xd7\xd7ࢵ\x05\x80S\x1d\xd6\xc0H\a$\x0e*D&\xfc-\"\a\xae\x0eh\x91|e|\xc1=j\x01VM\x93\x9cS\xf6\x8e\x8c\vHkoc\x97\x9d*\xe1\xfb\xcd\xcf\x1f\xeeT\xd8\xd7PeKU\xbfW\x8c\xc9\xe1\x06Y\x93\xe9E\xb9\x86MB\x83M\x12\x82\xfb\x8c\aY\a8\xea=(\x86\x0f\xf8\xb4\xba#\xaf\x91\x19\x9bd#\xbb\x9a\xb5\xd2A8\xf6\xe2{ \xe3v\x05\xc0\x8e|\xeck8\xbb\x9d\xa3\x1b(\xcbtg\xe8lc\x00N\xb7\xd6p\xf8\xe1\x96ďf\x90\xeam$e\x97\xe9J\x02\xbc\xf7\x14>\x9cAK`\xa6|c\xdc.ZE\x8b\xca\x05@O\x98.~q\x8f\xce?\xb9\xef\fچkh\x95M\f\xb2\xf6\x12k2\xdd+\x9d\x18ḥ\xa1n\x06\xb8l\xb4\x86?\xff*\x00\x0eʚ&e=_\xfa\x1e\xdd\xd7w\xef\x1f\xdem\xf4\x1e\xbbTW72s\x11<\x18\x06\x05\x83\xa3\x10<(-9\x01\x1d\x89Н\xd2f\\\xeb\xa9Kp\x83a\x00\xb5\xf51@\xd8#<\xa4\x9c\f\xa1W\x83@O\xbeG\nf$K~\x93\xee9\x9d\xcd||-Ad\x19h\xa4_\x90\x13\x86\x14\xb0\xf1\x0e\x1b\xe0\x14 \xf8\x16\xc2\xde0\x10&r]\xb8\xf4N\xfe}\vʁ\xdf\xfe\x8a:TC\xf4\f\xbc\xf7\xd16\xd2d\a\xa4\x00\x84\xda\xef\x9c\xf9\xe3d\x99\x85\x06\x81\xb4*\x8c\x054\xfe\xa5\xb6p\xca\n\xfd\x11\xbf\x04\xe5\x1a\xe8\xd4\x11\b\x05\x03\xa2\x9bXK\"\\\xc1O\x9e0\x11X\xc3>\x84\x9e\xeb\xd5jg\xc28/\xb4\xef\xba\xe8L8\xaeRכm\f\x9ex\xd5\xe0\x01\xed\x8aͮT\xa4\xf7&\xa0\x0e\x91p\xa5zS&ǝ\x04\xcbU\xd7|q*\x92\xd7\x13Og\xbd\x93\xcer\x8f\xdc\xe4]\xfa#WCV\xcb!\x9e\xe95n\x97\x12q\xff\xed\xe6#\x8c\xa0)\x05\x13\x930\xb0}V\xe33\xf1B\x94q-R҂\x96|\x97,\xa2kzo\\\xae%m\r\xbaK\xd29n;\x13x\xacR\xc9O\x05\xeb45a\x8b\x10\xfbF\x05l*x\xef`\xad:\xb4k\xc5\xf8\xbf\xd3.\fs)\x94>O\xfct؏\x7fY0\xb3u:\x1e'\xf1b\x86\x16\xbawӣ\x96\x9c\tq\xa2kZ\xa3S\x1b@\xeb\tԒJ\xf5\xac\x0fI\xfa_y1̈\xec\xc7lr\xf8\xf6y?\x96F\x85\xfc\xd2\x03sy4\xf3\xe6N$\xe6\xc8ִ\xa8\x8f\xdab6\x90'\x05>\xe7\x84\xfc\xd0\xc5n\x8eW\xca[uuv\xf9v=\x93\xff\xe1qٙ\xf1\x81\xbf\x15M\x96I\xcf\xd5t\xe4NF\xed`\x06(:'\x1d\xe9\x9d\x1cό\xc2\xe5D\x9eݚ\x80ݕ\x1f\x8b\x9e\xbcw\xad\x979\x19\x94@\xaa\x90\xfb\x04\x87\xa4\x0e\x18٣+s\xb7r\xba<\x8a^@`\xfe\x97'\xff?(\xca\xe80\x84\v\x98e\x1a\x8b\vǂtu\xbc\xd81\x83g\xd1Z\xb5\xb5XC\xa08\xd7\xccz\x8aH\x1d/n\xfa\xb1\x8cΫ[\xf1Oi\xb9\x12\x97\xda\x7fڣ\xbbU\xe1\xf0\xa4xfq\x82\n\xdb\xe3-\xc5\xf5i\a\x9d7I\xde\x04j\x90\xa9[\x06s\xc5\xd2\v\x88X\xc8R.Յ\xed\xe0\x8a\x84\xcdTr\xec\xfd\x8b\x82\x1f\x97\x85\xeae\xe0\vI\x9d\x1d\r\xf6j8\xbc=\x7f\r{\xb1\xd4\xc9p1D\xd1L\"\xe7\xe0I\xedF.γU֬>`3\xd9&\xa5\x0ekx\xf5\xeab\x17M\x9fڻ\xbc\x99s\r\x9f>\xcbn\x18<a3P\xc05|\xfa\\\xfc=\x00\x8a\x80\xfd\r\x9e\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WKo\xdc8\x12\xbe\xebW\x14\x92\x83/nu\x82\\\x16\xba,\fg\x17\xc8n\x1eF\xec\xf8\x12\xe4\xc0\x16K-NS\xa4\x86U\x94\xa7g0\xff}P\x14\xe5V\xbfl\a\x83\xb1\x1a0Xb\x15\xbf\xfa\xeaE\x15\x8bŢP\xbd\xb9\xc7@ƻ\nTo\xf07F'+*7\xff\xa2\xd2\xf8\xe5\xf0v\x85\xac\xde\x16\x1b\xe3t\x05ב\xd8w_\x91|\f5\xbe\xc7\xc68\xc3ƻ\xa2CVZ\xb1\xaa\n\x00\xe5\x9cg%b\x92%@\xed\x1d\ao-\x86\xc5\x1a]\xb9\x89+\\Ec5\x86t\xc2t\xfe\xf0\xa6|W\xbe)\x00\xea\x80I\xfd\xcetH\xac\xba\xbe\x02\x17\xad-\x00\x9c갂\xc1\xdb\xd8!9\xd5S\xeb\xd9\xfa:\xed\xa6r@\x8b\xc1\x97\xc6\x17\xd4c-g+\xad\x13>eo\x82q\x8c\xe1ZTG\\\v\xf8\xdf\xed\x97\xcf7\x8a\xdb\nJQ(\xfb\xe0\a\xa31$\xd0\xe3Q7s\x11o{\xac\x808\x18\xb7>40\x11P\x1e\x81\x9fY\xbbZ\xe3̐V,\xcbu\xf0\xb1\xaf`\a~t3s7\xf2~/\xb0\xf16{\xfc1{\x9c6XC\xfc\xff'6}4\xc4icocP\xf6,{i\x0f\x19\xb7\x8eV\x85s\xbb\n\x80> a\x18\xf0\x9b\xdb8\xff\xe0\xfek\xd0j\xaa\xa0Q\x96\xc4\x1b\xaa\xbd\x90\xf4YuH\xbd\xaaQ\x8b,\xaeBN\x19\xaa\xe0\x8f?\v\x80AY\xa3\x13\xbe\xd1Mߣ\xbb\xba\xf9p\xff\xee\xb6n\xb1Ki$b\x8dT\aӧ}g\xfc\x03C\xa0`\x02\b\x0f-\x06\x84\xfbD&\x10\xfb\x80\x94}\xc9&\x01&\xa7\xa8̢>\xf8\x1e\x03\x9b\x89syf\x85\xf1(;\xc0s!\x80\xc7=\xa0\xa5\x14\x90\x80[\x84a\x94\xa1\x06J\u0380o\x80[C\x100\x91\xe7x\x17\xbd\xe9\xf1\r(\a~\xf5\v\xd6\\\u00ad\x10\x1c\b\xa8\xf5\xd1j\xa9\x9f\x01\x03C\xc0گ\x9d\xf9\xfd\xd12\x01\xfbt\xa4U\x8c\xc4{\x16S\xba;e\x85ꈗ\xa0\x9c\x86Nm!\xa0\x9c\x01\xd1ͬ\xa5-T\xc2'\x1f\x10\x8ck|\x05-sO\xd5r\xb96<\xb5\x82\xdaw]t\x86\xb7\xcbT\xd0f\x15\xd9\aZj\x1c\xd0.ɬ\x17*ԭa\xac9\x06\\\xaa\xde,\x12p'\xceR\xd9\xe9\u05cfIp1CzPTI6f\xfdY\xde%\xddǰ\x8fj\xa3\x8b;z\x8d['V\xbe\xfe\xe7\xf6\x0e\xa6CS\bf&!\xb3\xbdS\xa3\x1d\xf1B\x94q\r\x86\xa4\x05M\xf0]\xb2\x88N\xf7\xde8N\x8b\xda\x1at\xfb\xa4S\\u\x86%ҿF$\x96\xf8\x94p\x9d\x1a\"\xac\x10b/5\xafK\xf8\xe0\xe0Zuh\xaf\x15\xe1?N\xbb0L\v\xa1\xf4y\xe2\xe7}|\xfa\x1b7\x8el=\x8a\xa7\x0e{2B\xa7+\xf5\xb6\xc7z\xafPĆiL\xae\xdc\xc6\aP3\x8b0U\xf1ikS\xf1\x9e+\xe0<x\x1a\xb3ޗ\xed\x0f\x85\xd3zg\xe99\xe1\xeb\xb5w\x8dYK:\x8a\x03\xd3\bYL\xbee\f1d'S\xbb,\x8bSg\x1d0,\xbf:\xa0\x96H*[=\x89\xe1q\x9b\x1c\xc7ʸ\xb1\x13\xed\xd4Sz\x85.wL\xc7\xe8tj\xcd\xfb\x0f\xfb\x94\xa5\x84\x1a\x1e\f\xb7c\xf2\xcfz?\xc0\xf3\x9c˳\xc1\xed\xb1\xf0\x00\xf3]\x8b\xb0\xc1\xed\xd8\x1c\x11\b\xeb\x80,\xfd\x8c\xd0JYJ͕\x00\x9f\"\xb1\x80RR\xe4\xe6\x18\xb2<Yw\x83\xdbCb\x9f\td\x9e\xcb\xcfA\xbd\x90i6\x01\r\xd8`@\xc7'\xcbV\xae6\xc1!c\xba;i_\x93\xf4\xca\x1a{\xa6\xa5\x1f0\f\x06\x1f\x96\x0f>l\x8c[/\x84\xe2\xc5\x18tZ\n\x10Z\xbeN\xffN\xe0\x01\xb8\xfb\xf2\xfeK\x05WZ\x83\xe7\x16\x03D\xc2&\xda)\xa1f\xf3\xea2u\xcfK\x88F\xff\xfb\xa28\xb2\xf34\x1f>EG\xd9g9\x91b6\xcdV\xe6m\x82#\xd4\u070eq\xf0\x01\xa4\aJp\xbb\x1c\xbd\xb1\xeaOEoD\xb3\xf2ޢ:L1\xe9\xa2&\xe0\xde$\x90\xdfB\x12\xe7\xe7Kh\xba\xcbU\xc5\x13~]\x1fm\x97\xd2\x16\xef\xdc,\tf\x855U\xfc\xe5\x81թ\xfbĀ\x1a\xbc\xcbI.\xe3\xe5\x12\xb8U</Zi\x1e\xc8u\x8b:\xa7\xfd\x87\x06\xb0\xeby{lt\xa6\x952QF\f\xa8\x89z3\x9e\x93\x1b\xa7\x9b.ae\xf1\xc2\x14\xe8_B\xd1!1\x93\xd2D\xcex\xddJ\x97/\xb5~\xe9٧b\xbdx4]<\x13hb\xc5q\xaf\x11\xbdd\x1e%\xa5\xec\xdb*Ϥ:\x06\xa9\xeel\x11|3\xb3\t\xa0\xfe\xfeL\xea[E\xf8$\xbf\xa7m߈\xdeD\xb95\r\xd6\xdb\xda\xe2hN\x98\xdf\x1f\x9d?5>\xe5\x87.v\x87\xa8\x16p5(c\xd5\xca\xe2ћoN\x9dyw&\xc0'\xe2v \xca\xf7\xe6\n\x86\xb7\xbbU\xfeT\x93T\xce/\xa4߇\x01u\x05\x1c\xe2\b,\xa7Z\x96\xec\x92A\xd5\xd2zQ\x7f>\xfc\x9ez\xf5j\xef\x93(-k\xef\xc6{\x01U\xf0\xfd\x87|\xb6\xc8׃\xce]\x95*\xf8\xfe\xa3\xf8k\x00E/\xfc\xae4\x0f\x00\x00"),
 }
 
 var CRDs = crds()
@@ -43,4 +43,5 @@ require (
 	k8s.io/klog v1.0.0
 	sigs.k8s.io/cluster-api v0.3.11-0.20210106212952-b6c1b5b3db3d
 	sigs.k8s.io/controller-runtime v0.7.1-0.20201215171748-096b2e07c091
+	sigs.k8s.io/yaml v1.2.0
 )
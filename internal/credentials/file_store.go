@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// ConfigKey is the key that the path of a location's credentials file is added
+// to the location's config under, so that plugins can use it when initializing.
+const ConfigKey = "credentialsFile"
+
+// FileStore makes credentials available to plugins as files on disk.
+type FileStore interface {
+	// Path fetches the credential identified by selector from the named
+	// provider, writes it to a file, and returns the file's path. An empty
+	// provider name refers to a Secret in the Velero namespace. The
+	// credential is fetched on every call so that rotated credentials are
+	// picked up.
+	Path(provider string, selector *corev1api.SecretKeySelector) (string, error)
+}
+
+type fileStore struct {
+	lock           sync.Mutex
+	secretProvider Provider
+	providers      map[string]Provider
+	root           string
+	fs             filesystem.Interface
+}
+
+// NewFileStore returns a FileStore that fetches credentials from secretProvider,
+// or from the named providers, and writes them under root.
+func NewFileStore(secretProvider Provider, providers map[string]Provider, root string, fs filesystem.Interface) (FileStore, error) {
+	if err := fs.MkdirAll(root, 0700); err != nil {
+		return nil, errors.Wrapf(err, "error creating credentials directory %s", root)
+	}
+
+	return &fileStore{
+		secretProvider: secretProvider,
+		providers:      providers,
+		root:           root,
+		fs:             fs,
+	}, nil
+}
+
+func (s *fileStore) Path(provider string, selector *corev1api.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", errors.New("credential selector must not be nil")
+	}
+
+	p := s.secretProvider
+	if provider != "" {
+		var ok bool
+		if p, ok = s.providers[provider]; !ok {
+			return "", errors.Errorf("credential provider %q is not configured on the server", provider)
+		}
+	}
+
+	data, err := p.Get(selector.Name, selector.Key)
+	if err != nil {
+		return "", err
+	}
+
+	// names from external secrets managers may contain path separators, so
+	// use a hash of the credential's identity as its file name.
+	path := filepath.Join(s.root, fmt.Sprintf("%x", sha256.Sum256([]byte(provider+"\x00"+selector.Name+"\x00"+selector.Key))))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// only rewrite the file when the credential has changed, so a plugin
+	// reading it is never handed a partially written file.
+	if existing, err := s.fs.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+		return path, nil
+	}
+
+	file, err := s.fs.Create(path)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating credentials file")
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return "", errors.Wrap(err, "error writing credentials file")
+	}
+
+	return path, nil
+}
+
+// ConfigWithCredentials returns a copy of config with the path of the file
+// holding the credential identified by provider and selector added under
+// ConfigKey. If selector is nil or has no name, config is returned unmodified
+// and store may be nil.
+func ConfigWithCredentials(config map[string]string, provider string, selector *corev1api.SecretKeySelector, store FileStore) (map[string]string, error) {
+	// locations created by older versions of the CLI have an empty, rather
+	// than a nil, selector when no credential was specified.
+	if selector == nil || selector.Name == "" {
+		return config, nil
+	}
+	if store == nil {
+		return nil, errors.New("a credential is specified but no credential store is configured")
+	}
+
+	path, err := store.Path(provider, selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting credentials")
+	}
+
+	res := make(map[string]string, len(config)+1)
+	for k, v := range config {
+		res[k] = v
+	}
+	res[ConfigKey] = path
+
+	return res, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeProvider returns the credential stored under "<name>/<key>".
+type fakeProvider map[string]string
+
+func (p fakeProvider) Get(name, key string) ([]byte, error) {
+	data, ok := p[name+"/"+key]
+	if !ok {
+		return nil, errors.Errorf("credential %s/%s not found", name, key)
+	}
+	return []byte(data), nil
+}
+
+func selector(name, key string) *corev1api.SecretKeySelector {
+	return &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: name},
+		Key:                  key,
+	}
+}
+
+func TestFileStorePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		selector *corev1api.SecretKeySelector
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "empty provider reads from the secret provider",
+			selector: selector("cloud-credentials", "cloud"),
+			want:     "from-secret",
+		},
+		{
+			name:     "named provider is used when specified",
+			provider: "vault",
+			selector: selector("secret/data/velero", "cloud"),
+			want:     "from-vault",
+		},
+		{
+			name:     "unknown provider is an error",
+			provider: "unknown",
+			selector: selector("cloud-credentials", "cloud"),
+			wantErr:  true,
+		},
+		{
+			name:     "provider error is returned",
+			selector: selector("missing", "cloud"),
+			wantErr:  true,
+		},
+		{
+			name:    "nil selector is an error",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := velerotest.NewFakeFileSystem()
+			store, err := NewFileStore(
+				fakeProvider{"cloud-credentials/cloud": "from-secret"},
+				map[string]Provider{"vault": fakeProvider{"secret/data/velero/cloud": "from-vault"}},
+				"/credentials",
+				fs,
+			)
+			require.NoError(t, err)
+
+			path, err := store.Path(tc.provider, tc.selector)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			data, err := fs.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(data))
+		})
+	}
+}
+
+func TestFileStorePathPicksUpRotatedCredentials(t *testing.T) {
+	fs := velerotest.NewFakeFileSystem()
+	provider := fakeProvider{"cloud-credentials/cloud": "old"}
+	store, err := NewFileStore(provider, nil, "/credentials", fs)
+	require.NoError(t, err)
+
+	path, err := store.Path("", selector("cloud-credentials", "cloud"))
+	require.NoError(t, err)
+
+	provider["cloud-credentials/cloud"] = "new"
+	rotatedPath, err := store.Path("", selector("cloud-credentials", "cloud"))
+	require.NoError(t, err)
+	assert.Equal(t, path, rotatedPath)
+
+	data, err := fs.ReadFile(rotatedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+}
+
+func TestConfigWithCredentials(t *testing.T) {
+	store, err := NewFileStore(fakeProvider{"cloud-credentials/cloud": "data"}, nil, "/credentials", velerotest.NewFakeFileSystem())
+	require.NoError(t, err)
+
+	config := map[string]string{"region": "us-east-1"}
+
+	res, err := ConfigWithCredentials(config, "", nil, store)
+	require.NoError(t, err)
+	assert.Equal(t, config, res)
+
+	res, err = ConfigWithCredentials(config, "", selector("", ""), nil)
+	require.NoError(t, err)
+	assert.Equal(t, config, res)
+
+	res, err = ConfigWithCredentials(config, "", selector("cloud-credentials", "cloud"), store)
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", res["region"])
+	assert.NotEmpty(t, res[ConfigKey])
+	assert.NotContains(t, config, ConfigKey, "input config must not be modified")
+
+	_, err = ConfigWithCredentials(config, "", selector("cloud-credentials", "cloud"), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultExecTimeout is how long an exec credential provider is given to
+// return a credential before it's killed.
+const DefaultExecTimeout = 30 * time.Second
+
+// Provider fetches credentials at runtime.
+type Provider interface {
+	// Get returns the credential stored under key in the secret with the
+	// given name.
+	Get(name, key string) ([]byte, error)
+}
+
+type secretProvider struct {
+	client    kbclient.Reader
+	namespace string
+}
+
+// NewSecretProvider returns a Provider that reads credentials from Kubernetes
+// Secrets in the given namespace. client should read from the API server rather
+// than a cache, so that the server doesn't need to watch every Secret.
+func NewSecretProvider(client kbclient.Reader, namespace string) Provider {
+	return &secretProvider{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (p *secretProvider) Get(name, key string) ([]byte, error) {
+	secret := new(corev1api.Secret)
+	if err := p.client.Get(context.Background(), kbclient.ObjectKey{Namespace: p.namespace, Name: name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s/%s", p.namespace, name)
+	}
+
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, errors.Errorf("secret %s/%s does not contain key %q", p.namespace, name, key)
+	}
+
+	return data, nil
+}
+
+type execProvider struct {
+	command string
+	timeout time.Duration
+}
+
+// NewExecProvider returns a Provider that fetches credentials by running the
+// executable at command with the secret name and key as its arguments. The
+// executable must write the credential to stdout and exit with status zero.
+// This allows credentials to be fetched from an external secrets manager, such
+// as Vault or a cloud provider's secret manager, each time they're used rather
+// than being stored in long-lived Kubernetes Secrets.
+func NewExecProvider(command string, timeout time.Duration) Provider {
+	return &execProvider{
+		command: command,
+		timeout: timeout,
+	}
+}
+
+func (p *execProvider) Get(name, key string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command, name, key)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = errors.Errorf("timed out after %s", p.timeout)
+		}
+		return nil, errors.Wrapf(err, "error running credential provider %s for secret %q, key %q: %s", p.command, name, key, strings.TrimSpace(stderr.String()))
+	}
+
+	if stdout.Len() == 0 {
+		return nil, errors.Errorf("credential provider %s returned an empty credential for secret %q, key %q", p.command, name, key)
+	}
+
+	return stdout.Bytes(), nil
+}
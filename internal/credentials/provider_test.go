@@ -0,0 +1,123 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretProviderGet(t *testing.T) {
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "cloud-credentials"},
+		Data:       map[string][]byte{"cloud": []byte("secret-value")},
+	}
+	provider := NewSecretProvider(fake.NewFakeClientWithScheme(scheme.Scheme, secret), "velero")
+
+	tests := []struct {
+		name    string
+		secret  string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "existing key is returned",
+			secret: "cloud-credentials",
+			key:    "cloud",
+			want:   "secret-value",
+		},
+		{
+			name:    "missing key is an error",
+			secret:  "cloud-credentials",
+			key:     "other",
+			wantErr: true,
+		},
+		{
+			name:    "missing secret is an error",
+			secret:  "missing",
+			key:     "cloud",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := provider.Get(tc.secret, tc.key)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(res))
+		})
+	}
+}
+
+func TestExecProviderGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "stdout is returned as the credential",
+			script: "#!/bin/sh\nprintf '%s:%s' \"$1\" \"$2\"\n",
+			want:   "cloud-credentials:cloud",
+		},
+		{
+			name:    "non-zero exit status is an error",
+			script:  "#!/bin/sh\necho denied >&2\nexit 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "empty output is an error",
+			script:  "#!/bin/sh\nexit 0\n",
+			wantErr: true,
+		},
+		{
+			name:    "timing out is an error",
+			script:  "#!/bin/sh\nsleep 5\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			command := filepath.Join(t.TempDir(), "provider")
+			require.NoError(t, ioutil.WriteFile(command, []byte(tc.script), 0700))
+
+			res, err := NewExecProvider(command, time.Second).Get("cloud-credentials", "cloud")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(res))
+		})
+	}
+}
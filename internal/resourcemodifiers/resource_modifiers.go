@@ -0,0 +1,247 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"encoding/json"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ConfigVersion is the only supported version of the resource modifiers
+// config map format.
+const ConfigVersion = "v1"
+
+// JSONPatch is a single RFC 6902 JSON patch operation.
+type JSONPatch struct {
+	// Operation is the patch operation: add, remove, replace, move, copy or test.
+	Operation string `json:"operation"`
+
+	// From is the source location for move and copy operations.
+	From string `json:"from,omitempty"`
+
+	// Path is the target location of the operation.
+	Path string `json:"path"`
+
+	// Value is the value for add, replace and test operations.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Conditions select the resources that a ResourceModifierRule applies to. All
+// of the specified conditions must match; unspecified conditions match
+// everything.
+type Conditions struct {
+	// APIVersion is the group/version of the resources to patch, e.g. "apps/v1".
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind is the kind of the resources to patch, e.g. "Deployment".
+	Kind string `json:"kind,omitempty"`
+
+	// ResourceNameRegex is a regular expression that resource names must match.
+	ResourceNameRegex string `json:"resourceNameRegex,omitempty"`
+
+	// Namespaces is a list of namespaces (globs are supported) that
+	// resources must be restored into. Cluster-scoped resources never
+	// match a non-empty list.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// LabelSelector is a label selector that resources must match.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ResourceModifierRule is a set of JSON patches to apply to the resources
+// matching its conditions.
+type ResourceModifierRule struct {
+	Conditions Conditions  `json:"conditions"`
+	Patches    []JSONPatch `json:"patches"`
+}
+
+// ResourceModifiers is the contents of a resource modifiers config map.
+type ResourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
+
+	compiled []compiledRule
+}
+
+type compiledRule struct {
+	rule       ResourceModifierRule
+	nameRegex  *regexp.Regexp
+	namespaces *collections.IncludesExcludes
+	selector   labels.Selector
+	patch      jsonpatch.Patch
+}
+
+// GetResourceModifiersFromConfig parses and validates the resource modifiers
+// stored in the provided config map, which must have exactly one data entry.
+func GetResourceModifiersFromConfig(cm *corev1api.ConfigMap) (*ResourceModifiers, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("resource modifiers configmap %s/%s must have exactly one data entry, found %d", cm.Namespace, cm.Name, len(cm.Data))
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	modifiers := new(ResourceModifiers)
+	if err := yaml.UnmarshalStrict([]byte(data), modifiers); err != nil {
+		return nil, errors.Wrapf(err, "error decoding resource modifiers from configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	if err := modifiers.compile(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource modifiers in configmap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	return modifiers, nil
+}
+
+func (m *ResourceModifiers) compile() error {
+	if m.Version != ConfigVersion {
+		return errors.Errorf("unsupported version %q, must be %q", m.Version, ConfigVersion)
+	}
+
+	m.compiled = nil
+	for i, rule := range m.ResourceModifierRules {
+		compiled := compiledRule{rule: rule, selector: labels.Everything()}
+
+		if rule.Conditions.ResourceNameRegex != "" {
+			regex, err := regexp.Compile(rule.Conditions.ResourceNameRegex)
+			if err != nil {
+				return errors.Wrapf(err, "rule %d: invalid resourceNameRegex", i)
+			}
+			compiled.nameRegex = regex
+		}
+
+		if len(rule.Conditions.Namespaces) > 0 {
+			compiled.namespaces = collections.NewIncludesExcludes().Includes(rule.Conditions.Namespaces...)
+		}
+
+		if rule.Conditions.LabelSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(rule.Conditions.LabelSelector)
+			if err != nil {
+				return errors.Wrapf(err, "rule %d: invalid labelSelector", i)
+			}
+			compiled.selector = selector
+		}
+
+		if len(rule.Patches) == 0 {
+			return errors.Errorf("rule %d: at least one patch is required", i)
+		}
+
+		patchJSON, err := json.Marshal(toRFC6902(rule.Patches))
+		if err != nil {
+			return errors.Wrapf(err, "rule %d: error encoding patches", i)
+		}
+		patch, err := jsonpatch.DecodePatch(patchJSON)
+		if err != nil {
+			return errors.Wrapf(err, "rule %d: invalid patches", i)
+		}
+		compiled.patch = patch
+
+		m.compiled = append(m.compiled, compiled)
+	}
+
+	return nil
+}
+
+// toRFC6902 converts patches to the field names used by RFC 6902.
+func toRFC6902(patches []JSONPatch) []map[string]interface{} {
+	var res []map[string]interface{}
+	for _, p := range patches {
+		op := map[string]interface{}{
+			"op":   p.Operation,
+			"path": p.Path,
+		}
+		if p.From != "" {
+			op["from"] = p.From
+		}
+		if p.Value != nil {
+			op["value"] = p.Value
+		}
+		res = append(res, op)
+	}
+	return res
+}
+
+// ApplyResourceModifierRules applies the patches of every rule whose conditions
+// match obj, in the order the rules are defined. obj is modified in place. If
+// applying a rule fails, obj is left unmodified by that rule and the error is
+// returned along with any others.
+func (m *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, log logrus.FieldLogger) []error {
+	var errs []error
+	for i, rule := range m.compiled {
+		if !rule.matches(obj) {
+			continue
+		}
+
+		log.Infof("Applying resource modifier rule %d", i)
+		if err := rule.apply(obj); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error applying resource modifier rule %d", i))
+		}
+	}
+	return errs
+}
+
+func (r *compiledRule) matches(obj *unstructured.Unstructured) bool {
+	if r.rule.Conditions.APIVersion != "" && r.rule.Conditions.APIVersion != obj.GetAPIVersion() {
+		return false
+	}
+	if r.rule.Conditions.Kind != "" && r.rule.Conditions.Kind != obj.GetKind() {
+		return false
+	}
+	if r.nameRegex != nil && !r.nameRegex.MatchString(obj.GetName()) {
+		return false
+	}
+	if r.namespaces != nil && (obj.GetNamespace() == "" || !r.namespaces.ShouldInclude(obj.GetNamespace())) {
+		return false
+	}
+	return r.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+func (r *compiledRule) apply(obj *unstructured.Unstructured) error {
+	objJSON, err := obj.MarshalJSON()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	patched, err := r.patch.Apply(objJSON)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	updated := new(unstructured.Unstructured)
+	if err := updated.UnmarshalJSON(patched); err != nil {
+		return errors.WithStack(err)
+	}
+
+	obj.Object = updated.Object
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func configMap(data string) *corev1api.ConfigMap {
+	return &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "modifiers"},
+		Data:       map[string]string{"modifiers.yaml": data},
+	}
+}
+
+func TestGetResourceModifiersFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cm      *corev1api.ConfigMap
+		wantErr bool
+	}{
+		{
+			name:    "nil config map is an error",
+			wantErr: true,
+		},
+		{
+			name: "config map with multiple data entries is an error",
+			cm: &corev1api.ConfigMap{
+				Data: map[string]string{"a": "", "b": ""},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version is an error",
+			cm:      configMap("version: v2\nresourceModifierRules: []\n"),
+			wantErr: true,
+		},
+		{
+			name:    "unknown fields are an error",
+			cm:      configMap("version: v1\nrules: []\n"),
+			wantErr: true,
+		},
+		{
+			name: "invalid name regex is an error",
+			cm: configMap(`version: v1
+resourceModifierRules:
+- conditions:
+    resourceNameRegex: "["
+  patches:
+  - operation: remove
+    path: /spec/nodeSelector
+`),
+			wantErr: true,
+		},
+		{
+			name: "rule without patches is an error",
+			cm: configMap(`version: v1
+resourceModifierRules:
+- conditions:
+    kind: Deployment
+`),
+			wantErr: true,
+		},
+		{
+			name: "valid config",
+			cm: configMap(`version: v1
+resourceModifierRules:
+- conditions:
+    apiVersion: apps/v1
+    kind: Deployment
+    resourceNameRegex: "^web-.*$"
+    namespaces: ["ns-*"]
+    labelSelector:
+      matchLabels:
+        app: web
+  patches:
+  - operation: replace
+    path: /spec/replicas
+    value: 1
+`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := GetResourceModifiersFromConfig(tc.cm)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res.ResourceModifierRules, 1)
+		})
+	}
+}
+
+func TestApplyResourceModifierRules(t *testing.T) {
+	modifiers, err := GetResourceModifiersFromConfig(configMap(`version: v1
+resourceModifierRules:
+- conditions:
+    kind: PersistentVolumeClaim
+    namespaces: ["ns-1"]
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+- conditions:
+    apiVersion: apps/v1
+    kind: Deployment
+    resourceNameRegex: "^web-.*$"
+    labelSelector:
+      matchLabels:
+        app: web
+  patches:
+  - operation: replace
+    path: /spec/replicas
+    value: 1
+  - operation: remove
+    path: /spec/template/spec/nodeSelector
+- conditions:
+    kind: ConfigMap
+  patches:
+  - operation: test
+    path: /data/foo
+    value: bar
+`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		obj      *unstructured.Unstructured
+		want     *unstructured.Unstructured
+		wantErrs int
+	}{
+		{
+			name: "matching PVC is patched",
+			obj:  velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-1","name":"pvc-1"},"spec":{"storageClassName":"standard"}}`),
+			want: velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-1","name":"pvc-1"},"spec":{"storageClassName":"premium"}}`),
+		},
+		{
+			name: "PVC in a non-matching namespace is not patched",
+			obj:  velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-2","name":"pvc-1"},"spec":{"storageClassName":"standard"}}`),
+			want: velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-2","name":"pvc-1"},"spec":{"storageClassName":"standard"}}`),
+		},
+		{
+			name: "matching deployment has all patches applied",
+			obj:  velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"web-1","labels":{"app":"web"}},"spec":{"replicas":3,"template":{"spec":{"nodeSelector":{"zone":"a"}}}}}`),
+			want: velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"web-1","labels":{"app":"web"}},"spec":{"replicas":1,"template":{"spec":{}}}}`),
+		},
+		{
+			name: "deployment not matching the label selector is not patched",
+			obj:  velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"web-1"},"spec":{"replicas":3}}`),
+			want: velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"web-1"},"spec":{"replicas":3}}`),
+		},
+		{
+			name: "deployment not matching the name regex is not patched",
+			obj:  velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"db-1","labels":{"app":"web"}},"spec":{"replicas":3}}`),
+			want: velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"db-1","labels":{"app":"web"}},"spec":{"replicas":3}}`),
+		},
+		{
+			name:     "failing patch returns an error and leaves the item unmodified",
+			obj:      velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"cm-1"},"data":{"foo":"baz"}}`),
+			want:     velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"cm-1"},"data":{"foo":"baz"}}`),
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := modifiers.ApplyResourceModifierRules(tc.obj, velerotest.NewLogger())
+			assert.Len(t, errs, tc.wantErrs)
+			assert.Equal(t, tc.want, tc.obj)
+		})
+	}
+}
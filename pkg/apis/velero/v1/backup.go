@@ -53,6 +53,14 @@ type BackupSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// OrLabelSelectors is a list of metav1.LabelSelector to filter with
+	// when adding individual objects to the backup. Objects matching any
+	// of the selectors are included. LabelSelector and OrLabelSelectors
+	// can't both be specified.
+	// +optional
+	// +nullable
+	OrLabelSelectors []*metav1.LabelSelector `json:"orLabelSelectors,omitempty"`
+
 	// SnapshotVolumes specifies whether to take cloud snapshots
 	// of any PV's referenced in the set of objects included
 	// in the Backup.
@@ -65,6 +73,14 @@ type BackupSpec struct {
 	// +optional
 	TTL metav1.Duration `json:"ttl,omitempty"`
 
+	// RetainLast is the number of most recent successful backups of the
+	// schedule that created this backup to keep, regardless of their TTL.
+	// It only applies to backups created by a schedule. If zero, backups
+	// are only garbage-collected based on their TTL.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetainLast int `json:"retainLast,omitempty"`
+
 	// IncludeClusterResources specifies whether cluster-scoped resources
 	// should be included for consideration in the backup.
 	// +optional
@@ -89,6 +105,15 @@ type BackupSpec struct {
 	// + nullable
 	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
 
+	// SnapshotMoveData specifies whether the contents of all persistent volume
+	// claims mounted by backed-up pods should be copied to the backup storage
+	// location with restic instead of taking native volume snapshots, so that
+	// the backup can be restored into freshly provisioned volumes in any
+	// cluster, including one on a different cloud provider.
+	// +optional
+	// +nullable
+	SnapshotMoveData *bool `json:"snapshotMoveData,omitempty"`
+
 	// OrderedResources specifies the backup order of resources of specific Kind.
 	// The map key is the Kind name and value is a list of resource names separated by commas.
 	// Each resource name has format "namespace/resourcename".  For cluster resources, simply use "resourcename".
@@ -35,6 +35,12 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
 
+	// CredentialProvider is the name of the credential provider, configured on
+	// the server, that Credential is fetched from. If empty, Credential refers
+	// to a Secret in the Velero namespace.
+	// +optional
+	CredentialProvider string `json:"credentialProvider,omitempty"`
+
 	StorageType `json:",inline"`
 
 	// Default indicates this location is the default backup storage location.
@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;RestoreLog;RestoreResults
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreDriftReport
 type DownloadTargetKind string
 
 const (
@@ -33,8 +33,10 @@ const (
 	DownloadTargetKindBackupContents        DownloadTargetKind = "BackupContents"
 	DownloadTargetKindBackupVolumeSnapshots DownloadTargetKind = "BackupVolumeSnapshots"
 	DownloadTargetKindBackupResourceList    DownloadTargetKind = "BackupResourceList"
+	DownloadTargetKindBackupResults         DownloadTargetKind = "BackupResults"
 	DownloadTargetKindRestoreLog            DownloadTargetKind = "RestoreLog"
 	DownloadTargetKindRestoreResults        DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindRestoreDriftReport    DownloadTargetKind = "RestoreDriftReport"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -89,6 +89,20 @@ type RestoreSpec struct {
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
 
+	// ResourceModifiers specifies the reference to a ConfigMap in the restore's
+	// namespace containing JSON patches to apply to resources before they are
+	// restored.
+	// +optional
+	// +nullable
+	ResourceModifiers *v1.TypedLocalObjectReference `json:"resourceModifiers,omitempty"`
+
+	// IdempotencyToken is an optional client-supplied token identifying this
+	// restore request. If another restore with the same token already exists
+	// and did not fail validation, this restore fails validation instead of
+	// running, so that retried create calls do not restore the same backup twice.
+	// +optional
+	IdempotencyToken string `json:"idempotencyToken,omitempty"`
+
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
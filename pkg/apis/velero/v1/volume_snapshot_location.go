@@ -64,6 +64,12 @@ type VolumeSnapshotLocationSpec struct {
 	// Credential contains the credential information intended to be used with this location
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
+
+	// CredentialProvider is the name of the credential provider, configured on
+	// the server, that Credential is fetched from. If empty, Credential refers
+	// to a Secret in the Velero namespace.
+	// +optional
+	CredentialProvider string `json:"credentialProvider,omitempty"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecycle phase of a Velero VolumeSnapshotLocation.
@@ -38,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -73,6 +74,7 @@ type kubernetesBackupper struct {
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
 	defaultVolumesToRestic bool
+	credentialStore        credentials.FileStore
 }
 
 type resolvedAction struct {
@@ -106,6 +108,7 @@ func NewKubernetesBackupper(
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
 	defaultVolumesToRestic bool,
+	credentialStore credentials.FileStore,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		backupClient:           backupClient,
@@ -115,6 +118,7 @@ func NewKubernetesBackupper(
 		resticBackupperFactory: resticBackupperFactory,
 		resticTimeout:          resticTimeout,
 		defaultVolumesToRestic: defaultVolumesToRestic,
+		credentialStore:        credentialStore,
 	}, nil
 }
 
@@ -291,6 +295,7 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   newPVCSnapshotTracker(),
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
+		credentialStore:         kb.credentialStore,
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
 		},
@@ -423,18 +428,26 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
 	backedUpItem, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR)
+
+	// include the item's namespace and resource so errors can be attributed to it
+	// in the backup's results.
+	itemLog := log.WithFields(logrus.Fields{
+		"name":      unstructured.GetName(),
+		"namespace": unstructured.GetNamespace(),
+		"resource":  gr.String(),
+	})
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
-		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
+		itemLog.Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
 		// accurate count of errors
 		for _, err = range aggregate.Errors() {
-			log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+			itemLog.WithError(err).Error("Error backing up item")
 		}
 
 		return false
 	}
 	if err != nil {
-		log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+		itemLog.WithError(err).Error("Error backing up item")
 		return false
 	}
 	return backedUpItem
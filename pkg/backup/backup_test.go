@@ -331,6 +331,62 @@ func TestBackupResourceFiltering(t *testing.T) {
 				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
 			},
 		},
+		{
+			name: "label selector with match expressions only backs up matching resources",
+			backup: defaultBackup().
+				LabelSelector(&metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "a", Operator: metav1.LabelSelectorOpIn, Values: []string{"b", "c"}},
+						{Key: "d", Operator: metav1.LabelSelectorOpDoesNotExist},
+					},
+				}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPod("foo", "baz").ObjectMeta(builder.WithLabels("a", "c", "d", "e")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithLabels("a", "d")).Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").ObjectMeta(builder.WithLabels("a", "c")).Result(),
+					builder.ForPersistentVolume("baz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/persistentvolumes/cluster/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
+			},
+		},
+		{
+			name: "or label selectors back up resources matching any of the selectors",
+			backup: defaultBackup().
+				OrLabelSelector([]*metav1.LabelSelector{
+					{MatchLabels: map[string]string{"a": "b"}},
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "c", Operator: metav1.LabelSelectorOpExists}}},
+				}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPod("foo", "baz").ObjectMeta(builder.WithLabels("a", "b", "c", "d")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithLabels("a", "d")).Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").ObjectMeta(builder.WithLabels("c", "")).Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/namespaces/foo/baz.json",
+				"resources/deployments.apps/namespaces/zoo/raz.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/baz.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+			},
+		},
 		{
 			name: "resources with velero.io/exclude-from-backup=true label are not included",
 			backup: defaultBackup().
@@ -33,7 +33,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -54,6 +56,7 @@ type itemBackupper struct {
 	resticBackupper         restic.Backupper
 	resticSnapshotTracker   *pvcSnapshotTracker
 	volumeSnapshotterGetter VolumeSnapshotterGetter
+	credentialStore         credentials.FileStore
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
@@ -142,7 +145,13 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
 			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
 			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic)) {
+			volumes := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic))
+			if boolptr.IsSetToTrue(ib.backupRequest.Spec.SnapshotMoveData) {
+				// copy the contents of all PVC volumes so the backup doesn't depend on native snapshots.
+				volumes = sets.NewString(volumes...).Insert(restic.GetPodPVCVolumesToMove(pod)...).List()
+			}
+
+			for _, volume := range volumes {
 				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
 					log.WithFields(map[string]interface{}{
 						"podVolume": volume,
@@ -377,7 +386,12 @@ func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeS
 		return nil, err
 	}
 
-	if err := bs.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentials(snapshotLocation.Spec.Config, snapshotLocation.Spec.CredentialProvider, snapshotLocation.Spec.Credential, ib.credentialStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials for volume snapshot location %s", snapshotLocation.Name)
+	}
+
+	if err := bs.Init(config); err != nil {
 		return nil, err
 	}
 
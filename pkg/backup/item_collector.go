@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
@@ -216,6 +217,12 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		cohabitator.seen = true
 	}
 
+	labelSelectors, err := GetLabelSelectors(&r.backupRequest.Spec)
+	if err != nil {
+		// This should never happen...
+		return nil, errors.Wrap(err, "invalid label selector")
+	}
+
 	namespacesToList := getNamespacesToList(r.backupRequest.NamespaceIncludesExcludes)
 
 	// Check if we're backing up namespaces, and only certain ones
@@ -224,15 +231,6 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		if err != nil {
 			log.WithError(err).Error("Error getting dynamic client")
 		} else {
-			var labelSelector labels.Selector
-			if r.backupRequest.Spec.LabelSelector != nil {
-				labelSelector, err = metav1.LabelSelectorAsSelector(r.backupRequest.Spec.LabelSelector)
-				if err != nil {
-					// This should never happen...
-					return nil, errors.Wrap(err, "invalid label selector")
-				}
-			}
-
 			var items []*kubernetesResource
 			for _, ns := range namespacesToList {
 				log = log.WithField("namespace", ns)
@@ -243,9 +241,8 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 					continue
 				}
 
-				labels := labels.Set(unstructured.GetLabels())
-				if labelSelector != nil && !labelSelector.Matches(labels) {
-					log.Info("Skipping namespace because it does not match the backup's label selector")
+				if !matchesAnySelector(labelSelectors, labels.Set(unstructured.GetLabels())) {
+					log.Info("Skipping namespace because it does not match the backup's label selectors")
 					continue
 				}
 
@@ -272,6 +269,15 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		namespacesToList = []string{""}
 	}
 
+	// list once per label selector, since the API server can't OR them
+	listSelectors := []string{""}
+	if len(labelSelectors) > 0 {
+		listSelectors = nil
+		for _, selector := range labelSelectors {
+			listSelectors = append(listSelectors, selector.String())
+		}
+	}
+
 	var items []*kubernetesResource
 
 	for _, namespace := range namespacesToList {
@@ -283,41 +289,47 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 			continue
 		}
 
-		var labelSelector string
-		if selector := r.backupRequest.Spec.LabelSelector; selector != nil {
-			labelSelector = metav1.FormatLabelSelector(selector)
-		}
-
-		log.Info("Listing items")
-		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
-		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error listing items")
-			continue
-		}
-		log.Infof("Retrieved %d items", len(unstructuredList.Items))
+		// items matching more than one selector are only collected once
+		collected := make(map[string]bool)
 
-		// collect the items
-		for i := range unstructuredList.Items {
-			item := &unstructuredList.Items[i]
-
-			if gr == kuberesource.Namespaces && !r.backupRequest.NamespaceIncludesExcludes.ShouldInclude(item.GetName()) {
-				log.WithField("name", item.GetName()).Info("Skipping namespace because it's excluded")
-				continue
-			}
-
-			path, err := r.writeToFile(item)
+		for _, labelSelector := range listSelectors {
+			log.WithField("labelSelector", labelSelector).Info("Listing items")
+			unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
 			if err != nil {
-				log.WithError(err).Error("Error writing item to file")
+				log.WithError(errors.WithStack(err)).Error("Error listing items")
 				continue
 			}
+			log.Infof("Retrieved %d items", len(unstructuredList.Items))
+
+			// collect the items
+			for i := range unstructuredList.Items {
+				item := &unstructuredList.Items[i]
+
+				key := item.GetNamespace() + "/" + item.GetName()
+				if collected[key] {
+					continue
+				}
+
+				if gr == kuberesource.Namespaces && !r.backupRequest.NamespaceIncludesExcludes.ShouldInclude(item.GetName()) {
+					log.WithField("name", item.GetName()).Info("Skipping namespace because it's excluded")
+					continue
+				}
 
-			items = append(items, &kubernetesResource{
-				groupResource: gr,
-				preferredGVR:  preferredGVR,
-				namespace:     item.GetNamespace(),
-				name:          item.GetName(),
-				path:          path,
-			})
+				path, err := r.writeToFile(item)
+				if err != nil {
+					log.WithError(err).Error("Error writing item to file")
+					continue
+				}
+
+				collected[key] = true
+				items = append(items, &kubernetesResource{
+					groupResource: gr,
+					preferredGVR:  preferredGVR,
+					namespace:     item.GetNamespace(),
+					name:          item.GetName(),
+					path:          path,
+				})
+			}
 		}
 	}
 	if len(orders) > 0 {
@@ -383,6 +395,51 @@ func coreGroupResourcePriority(resource string) int {
 	return other
 }
 
+// GetLabelSelectors returns the label selectors from the backup spec, at least
+// one of which an item must match to be included in the backup. If the spec
+// doesn't filter items by label, the result is empty.
+func GetLabelSelectors(spec *velerov1api.BackupSpec) ([]labels.Selector, error) {
+	var selectors []labels.Selector
+
+	if spec.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.LabelSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid labelSelector")
+		}
+		selectors = append(selectors, selector)
+	}
+
+	for i, orSelector := range spec.OrLabelSelectors {
+		if orSelector == nil {
+			return nil, errors.Errorf("orLabelSelectors[%d] must not be null", i)
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(orSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid orLabelSelectors[%d]", i)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	return selectors, nil
+}
+
+// matchesAnySelector returns true if selectors is empty or at least one of
+// them matches the provided labels.
+func matchesAnySelector(selectors []labels.Selector, set labels.Set) bool {
+	if len(selectors) == 0 {
+		return true
+	}
+
+	for _, selector := range selectors {
+		if selector.Matches(set) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getNamespacesToList examines ie and resolves the includes and excludes to a full list of
 // namespaces to list. If ie is nil or it includes *, the result is just "" (list across all
 // namespaces). Otherwise, the result is a list of every included namespace minus all excluded ones.
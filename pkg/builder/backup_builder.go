@@ -141,6 +141,12 @@ func (b *BackupBuilder) LabelSelector(selector *metav1.LabelSelector) *BackupBui
 	return b
 }
 
+// OrLabelSelector sets the Backup's orLabelSelectors.
+func (b *BackupBuilder) OrLabelSelector(orSelectors []*metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.OrLabelSelectors = orSelectors
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -153,6 +159,12 @@ func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	return b
 }
 
+// SnapshotMoveData sets the Backup's "SnapshotMoveData" flag.
+func (b *BackupBuilder) SnapshotMoveData(val bool) *BackupBuilder {
+	b.object.Spec.SnapshotMoveData = &val
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -177,6 +189,12 @@ func (b *BackupBuilder) TTL(ttl time.Duration) *BackupBuilder {
 	return b
 }
 
+// RetainLast sets the Backup's retain last count.
+func (b *BackupBuilder) RetainLast(count int) *BackupBuilder {
+	b.object.Spec.RetainLast = count
+	return b
+}
+
 // Expiration sets the Backup's expiration.
 func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	b.object.Status.Expiration = &metav1.Time{Time: val}
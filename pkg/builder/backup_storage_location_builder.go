@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -89,6 +90,18 @@ func (b *BackupStorageLocationBuilder) Default(isDefault bool) *BackupStorageLoc
 	return b
 }
 
+// Credential sets the BackupStorageLocation's credential selector.
+func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.Credential = selector
+	return b
+}
+
+// CredentialProvider sets the name of the BackupStorageLocation's credential provider.
+func (b *BackupStorageLocationBuilder) CredentialProvider(name string) *BackupStorageLocationBuilder {
+	b.object.Spec.CredentialProvider = name
+	return b
+}
+
 // AccessMode sets the BackupStorageLocation's access mode.
 func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupStorageLocationAccessMode) *BackupStorageLocationBuilder {
 	b.object.Spec.AccessMode = accessMode
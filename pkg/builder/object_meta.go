@@ -141,6 +141,14 @@ func WithDeletionTimestamp(val time.Time) func(obj metav1.Object) {
 	}
 }
 
+// WithCreationTimestamp is a functional option that applies the specified
+// creation timestamp to an object.
+func WithCreationTimestamp(val time.Time) func(obj metav1.Object) {
+	return func(obj metav1.Object) {
+		obj.SetCreationTimestamp(metav1.Time{Time: val})
+	}
+}
+
 // WithUID is a functional option that applies the specified UID to an object.
 func WithUID(val string) func(obj metav1.Object) {
 	return func(obj metav1.Object) {
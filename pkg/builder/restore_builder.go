@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -142,6 +143,21 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// ResourceModifiers sets the Restore's resource modifiers config map reference.
+func (b *RestoreBuilder) ResourceModifiers(kind, name string) *RestoreBuilder {
+	b.object.Spec.ResourceModifiers = &corev1api.TypedLocalObjectReference{
+		Kind: kind,
+		Name: name,
+	}
+	return b
+}
+
+// IdempotencyToken sets the Restore's idempotency token.
+func (b *RestoreBuilder) IdempotencyToken(token string) *RestoreBuilder {
+	b.object.Spec.IdempotencyToken = token
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
@@ -86,12 +86,14 @@ type CreateOptions struct {
 	TTL                     time.Duration
 	SnapshotVolumes         flag.OptionalBool
 	DefaultVolumesToRestic  flag.OptionalBool
+	SnapshotMoveData        flag.OptionalBool
 	IncludeNamespaces       flag.StringArray
 	ExcludeNamespaces       flag.StringArray
 	IncludeResources        flag.StringArray
 	ExcludeResources        flag.StringArray
 	Labels                  flag.Map
 	Selector                flag.LabelSelector
+	OrSelector              flag.OrLabelSelector
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	StorageLocation         string
@@ -122,6 +124,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
+	flags.Var(&o.OrSelector, "or-selector", "Only back up resources matching at least one of these label selectors, separated by ' or ', e.g. 'app=web or tier in (db, cache)'. Cannot be used with --selector.")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
@@ -133,6 +136,9 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
 	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Copy the contents of all persistent volume claims used by pods to the backup storage location with restic instead of taking native snapshots, so the backup can be restored on any provider")
+	f.NoOptDefVal = "true"
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -162,6 +168,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("A backup name is required, unless you are creating based on a schedule.")
 	}
 
+	if o.Selector.LabelSelector != nil && len(o.OrSelector.OrLabelSelectors) > 0 {
+		return fmt.Errorf("only one of --selector and --or-selector can be specified")
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := client.Get(context.Background(), kbclient.ObjectKey{
@@ -326,6 +336,7 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			IncludedResources(o.IncludeResources...).
 			ExcludedResources(o.ExcludeResources...).
 			LabelSelector(o.Selector.LabelSelector).
+			OrLabelSelector(o.OrSelector.OrLabelSelectors).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
 			VolumeSnapshotLocations(o.SnapshotLocations...)
@@ -346,6 +357,9 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.DefaultVolumesToRestic.Value != nil {
 			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
 		}
+		if o.SnapshotMoveData.Value != nil {
+			backupBuilder.SnapshotMoveData(*o.SnapshotMoveData.Value)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
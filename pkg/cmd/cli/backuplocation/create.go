@@ -65,6 +65,7 @@ type CreateOptions struct {
 	Provider                              string
 	Bucket                                string
 	Credential                            flag.Map
+	CredentialProvider                    string
 	DefaultBackupStorageLocation          bool
 	Prefix                                string
 	BackupSyncPeriod, ValidationFrequency time.Duration
@@ -90,6 +91,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the backup storage provider (e.g. aws, azure, gcp).")
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.StringVar(&o.CredentialProvider, "credential-provider", o.CredentialProvider, "Name of the credential provider, configured on the server with --credential-providers, that --credential is fetched from. Optional; by default, --credential refers to a Kubernetes Secret.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
@@ -125,6 +127,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.CredentialProvider != "" && len(o.Credential.Data()) == 0 {
+		return errors.New("--credential-provider requires --credential")
+	}
+
 	return nil
 }
 
@@ -185,6 +191,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				},
 				Key: secretKey,
 			},
+			CredentialProvider:  o.CredentialProvider,
 			Default:             o.DefaultBackupStorageLocation,
 			AccessMode:          velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
 			BackupSyncPeriod:    backupSyncPeriod,
@@ -58,6 +58,7 @@ type SetOptions struct {
 	Name                         string
 	CACertFile                   string
 	Credential                   flag.Map
+	CredentialProvider           string
 	DefaultBackupStorageLocation bool
 }
 
@@ -70,6 +71,7 @@ func NewSetOptions() *SetOptions {
 func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.StringVar(&o.CredentialProvider, "credential-provider", o.CredentialProvider, "Sets the name of the credential provider, configured on the server with --credential-providers, that --credential is fetched from. Optional; by default, --credential refers to a Kubernetes Secret.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 }
 
@@ -149,6 +151,10 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if c.Flags().Changed("credential-provider") {
+		location.Spec.CredentialProvider = o.CredentialProvider
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -89,6 +90,8 @@ type CreateOptions struct {
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	AllowPartiallyFailed    flag.OptionalBool
+	ResourceModifiers       string
+	IdempotencyToken        string
 
 	client veleroclient.Interface
 }
@@ -114,6 +117,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
+	flags.StringVar(&o.ResourceModifiers, "resource-modifier-configmap", "", "Name of a ConfigMap in the Velero namespace containing JSON patches to apply to resources before they are restored.")
+	flags.StringVar(&o.IdempotencyToken, "idempotency-token", "", "Token identifying this restore request. If a restore with the same token already exists, it is used instead of creating a new one.")
 	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
 	// like a normal bool flag
@@ -269,13 +274,34 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			IdempotencyToken:        o.IdempotencyToken,
 		},
 	}
 
+	if o.ResourceModifiers != "" {
+		restore.Spec.ResourceModifiers = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.ResourceModifiers,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
 
+	// if a restore with the same idempotency token already exists, report on it rather than
+	// creating a new one. The server rejects duplicates that are created concurrently.
+	var existing *api.Restore
+	if o.IdempotencyToken != "" {
+		var err error
+		if existing, err = o.findRestoreWithIdempotencyToken(f.Namespace()); err != nil {
+			return err
+		}
+		if existing != nil {
+			o.RestoreName = existing.Name
+		}
+	}
+
 	var restoreInformer cache.SharedIndexInformer
 	var updates chan *api.Restore
 	if o.Wait {
@@ -316,12 +342,23 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		go restoreInformer.Run(stop)
 	}
 
-	restore, err := o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
-	if err != nil {
-		return err
+	if existing != nil {
+		restore = existing
+		fmt.Printf("Restore request %q with idempotency token %q already exists.\n", restore.Name, o.IdempotencyToken)
+
+		if o.Wait && restore.Status.Phase != "" && restore.Status.Phase != api.RestorePhaseNew && restore.Status.Phase != api.RestorePhaseInProgress {
+			fmt.Printf("Restore completed with status: %s. You may check for more information using the commands `velero restore describe %s` and `velero restore logs %s`.\n", restore.Status.Phase, restore.Name, restore.Name)
+			return nil
+		}
+	} else {
+		var err error
+		if restore, err = o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		fmt.Printf("Restore request %q submitted successfully.\n", restore.Name)
 	}
 
-	fmt.Printf("Restore request %q submitted successfully.\n", restore.Name)
 	if o.Wait {
 		fmt.Println("Waiting for restore to complete. You may safely press ctrl-c to stop waiting - your restore will continue in the background.")
 		ticker := time.NewTicker(time.Second)
@@ -351,3 +388,24 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 
 	return nil
 }
+
+// findRestoreWithIdempotencyToken returns the earliest-created restore with the configured
+// idempotency token that did not fail validation, or nil if there isn't one.
+func (o *CreateOptions) findRestoreWithIdempotencyToken(namespace string) (*api.Restore, error) {
+	restores, err := o.client.VeleroV1().Restores(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var res *api.Restore
+	for i, restore := range restores.Items {
+		if restore.Spec.IdempotencyToken != o.IdempotencyToken || restore.Status.Phase == api.RestorePhaseFailedValidation {
+			continue
+		}
+		if res == nil || restore.CreationTimestamp.Before(&res.CreationTimestamp) {
+			res = &restores.Items[i]
+		}
+	}
+
+	return res, nil
+}
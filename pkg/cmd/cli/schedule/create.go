@@ -62,7 +62,10 @@ example: "@every 2h30m".`,
   velero create schedule NAME --schedule="@every 24h" --include-namespaces web
 
   # Create a weekly backup, each living for 90 days (2160 hours).
-  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s`,
+  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+  # Create a daily backup, always keeping the 7 most recent successful backups even after they expire.
+  velero create schedule NAME --schedule="@every 24h" --retain-last 7`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -82,6 +85,7 @@ type CreateOptions struct {
 	BackupOptions              *backup.CreateOptions
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
+	RetainLast                 int
 
 	labelSelector *metav1.LabelSelector
 }
@@ -96,6 +100,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule")
+	flags.IntVar(&o.RetainLast, "retain-last", o.RetainLast, "Number of most recent successful backups created by this Schedule to keep regardless of their TTL. If zero, backups are only garbage collected based on their TTL.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -103,6 +108,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.RetainLast < 0 {
+		return errors.New("--retain-last must be zero or greater")
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -130,11 +139,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				ExcludedResources:       o.BackupOptions.ExcludeResources,
 				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
 				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
+				OrLabelSelectors:        o.BackupOptions.OrSelector.OrLabelSelectors,
 				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
 				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
 				StorageLocation:         o.BackupOptions.StorageLocation,
 				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
 				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
+				SnapshotMoveData:        o.BackupOptions.SnapshotMoveData.Value,
+				RetainLast:              o.RetainLast,
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
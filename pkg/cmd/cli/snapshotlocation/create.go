@@ -56,13 +56,14 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name       string
-	Provider   string
-	Config     flag.Map
-	Labels     flag.Map
-	Credential flag.Map
-	secretName string
-	secretKey  string
+	Name               string
+	Provider           string
+	Config             flag.Map
+	Labels             flag.Map
+	Credential         flag.Map
+	CredentialProvider string
+	secretName         string
+	secretKey          string
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -77,6 +78,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the volume snapshot location.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.StringVar(&o.CredentialProvider, "credential-provider", o.CredentialProvider, "Name of the credential provider, configured on the server with --credential-providers, that --credential is fetched from. Optional; by default, --credential refers to a Kubernetes Secret.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -92,6 +94,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.CredentialProvider != "" && len(o.Credential.Data()) == 0 {
+		return errors.New("--credential-provider requires --credential")
+	}
+
 	for k, v := range o.Credential.Data() {
 		o.secretName = k
 		o.secretKey = v
@@ -121,6 +127,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				},
 				Key: o.secretKey,
 			},
+			CredentialProvider: o.CredentialProvider,
 		},
 	}
 
@@ -53,8 +53,9 @@ func NewSetCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type SetOptions struct {
-	Name       string
-	Credential flag.Map
+	Name               string
+	Credential         flag.Map
+	CredentialProvider string
 }
 
 func NewSetOptions() *SetOptions {
@@ -65,6 +66,7 @@ func NewSetOptions() *SetOptions {
 
 func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.StringVar(&o.CredentialProvider, "credential-provider", o.CredentialProvider, "Sets the name of the credential provider, configured on the server with --credential-providers, that --credential is fetched from. Optional; by default, --credential refers to a Kubernetes Secret.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -109,6 +111,10 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if c.Flags().Changed("credential-provider") {
+		location.Spec.CredentialProvider = o.CredentialProvider
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -68,12 +69,14 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/util/managercontroller"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -95,6 +98,16 @@ const (
 	defaultProfilerAddress = "localhost:6060"
 
 	defaultControllerWorkers = 1
+
+	// the default name of the config map holding settings that can be changed while the server is running
+	defaultServerConfigMapName = "velero-server-config"
+
+	// the directory that credentials fetched for backup and volume snapshot
+	// locations are written to, for plugins to read
+	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// the default number of items of a resource type that are restored concurrently
+	defaultRestoreItemWorkers = 1
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
 )
@@ -110,10 +123,15 @@ type serverConfig struct {
 	disabledControllers                                                     []string
 	clientQPS                                                               float32
 	clientBurst                                                             int
+	restoreItemWorkers                                                      int
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
 	defaultVolumesToRestic                                                  bool
+	backupDeletionAllowedNamespaces                                         []string
+	backupDeletionLabelSelector, backupDeletionApprovalAnnotation           string
+	serverConfigMapName                                                     string
+	credentialProviders                                                     map[string]string
 }
 
 type controllerRunInfo struct {
@@ -124,6 +142,7 @@ type controllerRunInfo struct {
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
+		credentialProviders     = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                         "/plugins",
@@ -137,11 +156,13 @@ func NewCommand(f client.Factory) *cobra.Command {
 			restoreResourcePriorities:         defaultRestorePriorities,
 			clientQPS:                         defaultClientQPS,
 			clientBurst:                       defaultClientBurst,
+			restoreItemWorkers:                defaultRestoreItemWorkers,
 			profilerAddress:                   defaultProfilerAddress,
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
 			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			serverConfigMapName:               defaultServerConfigMapName,
 		}
 	)
 
@@ -177,6 +198,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			if credentialProviders.Data() != nil {
+				config.credentialProviders = credentialProviders.Data()
+			}
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -195,6 +220,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "Run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("List of controllers to disable on startup. Valid values are %s", strings.Join(controller.DisableableControllers, ",")))
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "Desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources.")
+	command.Flags().IntVar(&config.restoreItemWorkers, "restore-item-workers", config.restoreItemWorkers, "Number of items of each resource type to restore concurrently. Persistent volumes are always restored one at a time.")
 	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "Name of the default backup storage location. DEPRECATED: this flag will be removed in v2.0. Use \"velero backup-location set --default\" instead.")
 	command.Flags().DurationVar(&config.storeValidationFrequency, "store-validation-frequency", config.storeValidationFrequency, "How often to verify if the storage is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "List of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
@@ -205,6 +231,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().StringSliceVar(&config.backupDeletionAllowedNamespaces, "backup-deletion-allowed-namespaces", config.backupDeletionAllowedNamespaces, "List of namespaces (globs are supported) that a backup may include and still be deleted via a DeleteBackupRequest. A backup of all namespaces can only be deleted if '*' is in the list. Optional.")
+	command.Flags().StringVar(&config.backupDeletionLabelSelector, "backup-deletion-label-selector", config.backupDeletionLabelSelector, "Label selector that a backup must match to be deleted via a DeleteBackupRequest. Optional.")
+	command.Flags().StringVar(&config.backupDeletionApprovalAnnotation, "backup-deletion-approval-annotation", config.backupDeletionApprovalAnnotation, "Name of an annotation that must be set to \"true\" on a backup for it to be deleted via a DeleteBackupRequest. Optional.")
+	command.Flags().Var(&credentialProviders, "credential-providers", "List of named credential providers and the executables that fetch their credentials (name1=/path/to/executable1,name2=/path/to/executable2,...). Backup and volume snapshot locations select one with their credentialProvider field. Optional.")
+	command.Flags().StringVar(&config.serverConfigMapName, "server-config-configmap", config.serverConfigMapName, "Name of a ConfigMap in the Velero namespace whose settings (currently \"log-level\") are applied while the server is running.")
 
 	return command
 }
@@ -224,12 +255,15 @@ type server struct {
 	ctx                                 context.Context
 	cancelFunc                          context.CancelFunc
 	logger                              logrus.FieldLogger
+	rootLogger                          *logrus.Logger
 	logLevel                            logrus.Level
 	pluginRegistry                      clientmgmt.Registry
 	resticManager                       restic.RepositoryManager
 	metrics                             *metrics.ServerMetrics
 	config                              serverConfig
 	mgr                                 manager.Manager
+	backupDeletionPolicy                controller.BackupDeletionPolicy
+	credentialFileStore                 credentials.FileStore
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -243,6 +277,15 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	}
 	f.SetClientBurst(config.clientBurst)
 
+	if config.restoreItemWorkers <= 0 {
+		return nil, errors.New("restore-item-workers must be positive")
+	}
+
+	backupDeletionPolicy, err := controller.NewBackupDeletionPolicy(config.backupDeletionAllowedNamespaces, config.backupDeletionLabelSelector, config.backupDeletionApprovalAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -286,6 +329,7 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
+	corev1api.AddToScheme(scheme)
 	mgr, err := ctrl.NewManager(clientConfig, ctrl.Options{
 		Scheme: scheme,
 	})
@@ -294,6 +338,22 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	credentialProviders := make(map[string]credentials.Provider)
+	for name, command := range config.credentialProviders {
+		credentialProviders[name] = credentials.NewExecProvider(command, credentials.DefaultExecTimeout)
+	}
+
+	credentialFileStore, err := credentials.NewFileStore(
+		credentials.NewSecretProvider(mgr.GetAPIReader(), f.Namespace()),
+		credentialProviders,
+		defaultCredentialsDirectory,
+		filesystem.NewFileSystem(),
+	)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
 	s := &server{
 		namespace:                           f.Namespace(),
 		metricsAddress:                      config.metricsAddress,
@@ -308,10 +368,13 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		ctx:                                 ctx,
 		cancelFunc:                          cancelFunc,
 		logger:                              logger,
+		rootLogger:                          logger,
 		logLevel:                            logger.Level,
 		pluginRegistry:                      pluginRegistry,
 		config:                              config,
 		mgr:                                 mgr,
+		backupDeletionPolicy:                backupDeletionPolicy,
+		credentialFileStore:                 credentialFileStore,
 	}
 
 	return s, nil
@@ -583,7 +646,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.kubeClient,
 			s.config.defaultBackupLocation,
 			newPluginManager,
-			persistence.NewObjectBackupStoreGetter(),
+			persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 			s.logger,
 		)
 
@@ -604,6 +667,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
+			s.credentialFileStore,
 		)
 		cmd.CheckError(err)
 
@@ -626,7 +690,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.formatFlag.Parse(),
 			csiVSLister,
 			csiVSCLister,
-			persistence.NewObjectBackupStoreGetter(),
+			persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 		)
 
 		return controllerRunInfo{
@@ -658,6 +722,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests().Lister(),
 			s.veleroClient.VeleroV1(),
 			s.mgr.GetClient(),
+			s.sharedInformerFactory.Velero().V1().Schedules().Lister(),
 		)
 
 		return controllerRunInfo{
@@ -683,9 +748,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSCLister,
 			s.csiSnapshotClient,
 			newPluginManager,
-			persistence.NewObjectBackupStoreGetter(),
+			persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 			s.metrics,
 			s.discoveryHelper,
+			s.backupDeletionPolicy,
+			s.credentialFileStore,
 		)
 
 		return controllerRunInfo{
@@ -706,6 +773,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
+			s.config.restoreItemWorkers,
+			s.credentialFileStore,
 		)
 		cmd.CheckError(err)
 
@@ -721,9 +790,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.logLevel,
 			newPluginManager,
-			persistence.NewObjectBackupStoreGetter(),
+			persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.kubeClient.CoreV1(),
 		)
 
 		return controllerRunInfo{
@@ -756,7 +826,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.mgr.GetClient(),
 			s.sharedInformerFactory.Velero().V1().Backups().Lister(),
 			newPluginManager,
-			persistence.NewObjectBackupStoreGetter(),
+			persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 			s.logger,
 		)
 
@@ -766,6 +836,33 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	serverConfigControllerRunInfo := func() controllerRunInfo {
+		// use a stand-alone config map informer filtered to the server config map
+		configMapInformer := corev1informers.NewFilteredConfigMapInformer(
+			s.kubeClient,
+			s.namespace,
+			0,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			func(opts *metav1.ListOptions) {
+				opts.FieldSelector = fmt.Sprintf("metadata.name=%s", s.config.serverConfigMapName)
+			},
+		)
+		go configMapInformer.Run(s.ctx.Done())
+
+		serverConfigController := controller.NewServerConfigController(
+			s.logger,
+			s.namespace,
+			s.config.serverConfigMapName,
+			configMapInformer,
+			s.rootLogger,
+		)
+
+		return controllerRunInfo{
+			controller: serverConfigController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	enabledControllers := map[string]func() controllerRunInfo{
 		controller.BackupSync:        backupSyncControllerRunInfo,
 		controller.Backup:            backupControllerRunInfo,
@@ -775,6 +872,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		controller.Restore:           restoreControllerRunInfo,
 		controller.ResticRepo:        resticRepoControllerRunInfo,
 		controller.DownloadRequest:   downloadrequestControllerRunInfo,
+		controller.ServerConfig:      serverConfigControllerRunInfo,
 	}
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
@@ -835,7 +933,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			ServerValidationFrequency: s.config.storeValidationFrequency,
 		},
 		NewPluginManager:  newPluginManager,
-		BackupStoreGetter: persistence.NewObjectBackupStoreGetter(),
+		BackupStoreGetter: persistence.NewObjectBackupStoreGetter(s.credentialFileStore),
 		Log:               s.logger,
 	}
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
@@ -97,6 +97,7 @@ func TestRemoveControllers(t *testing.T) {
 				controller.ResticRepo,
 				controller.Restore,
 				controller.Schedule,
+				controller.ServerConfig,
 				controller.ServerStatusRequest,
 			},
 			errorExpected: false,
@@ -128,6 +129,7 @@ func TestRemoveControllers(t *testing.T) {
 				controller.Restore:           func() controllerRunInfo { return controllerRunInfo{} },
 				controller.ResticRepo:        func() controllerRunInfo { return controllerRunInfo{} },
 				controller.DownloadRequest:   func() controllerRunInfo { return controllerRunInfo{} },
+				controller.ServerConfig:      func() controllerRunInfo { return controllerRunInfo{} },
 			}
 
 			enabledRuntimeControllers := map[string]struct{}{
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// orSeparator separates the label selectors in an OrLabelSelector flag value.
+const orSeparator = " or "
+
+// OrLabelSelector is a Cobra-compatible wrapper for defining
+// a flag containing a list of Kubernetes label-selectors,
+// separated by " or ".
+type OrLabelSelector struct {
+	OrLabelSelectors []*metav1.LabelSelector
+}
+
+// String returns a string representation of the or-label-selector
+// flag.
+func (ls *OrLabelSelector) String() string {
+	var selectors []string
+	for _, selector := range ls.OrLabelSelectors {
+		selectors = append(selectors, metav1.FormatLabelSelector(selector))
+	}
+	return strings.Join(selectors, orSeparator)
+}
+
+// Set parses the provided string and assigns the result
+// to the or-label-selector receiver. It returns an error if
+// any of the selectors in the string is not parseable.
+func (ls *OrLabelSelector) Set(s string) error {
+	var selectors []*metav1.LabelSelector
+	for _, selector := range strings.Split(s, orSeparator) {
+		parsed, err := metav1.ParseToLabelSelector(strings.TrimSpace(selector))
+		if err != nil {
+			return err
+		}
+		selectors = append(selectors, parsed)
+	}
+	ls.OrLabelSelectors = selectors
+	return nil
+}
+
+// Type returns a string representation of the
+// OrLabelSelector type.
+func (ls *OrLabelSelector) Type() string {
+	return "orLabelSelector"
+}
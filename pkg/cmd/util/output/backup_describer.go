@@ -33,6 +33,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -86,6 +87,10 @@ func DescribeBackup(
 		d.Printf("Errors:\t%d\n", status.Errors)
 		d.Printf("Warnings:\t%d\n", status.Warnings)
 
+		if details {
+			describeBackupResults(d, backup, veleroClient, insecureSkipTLSVerify, caCertFile)
+		}
+
 		d.Println()
 		DescribeBackupSpec(d, backup.Spec)
 
@@ -152,14 +157,26 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 	d.Printf("Label selector:\t%s\n", s)
 
+	if len(spec.OrLabelSelectors) > 0 {
+		var orSelectors []string
+		for _, selector := range spec.OrLabelSelectors {
+			orSelectors = append(orSelectors, metav1.FormatLabelSelector(selector))
+		}
+		d.Printf("Or label selectors:\t%s\n", strings.Join(orSelectors, " or "))
+	}
+
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	d.Printf("Move PV Data:\t%s\n", BoolPointerString(spec.SnapshotMoveData, "false", "true", "false"))
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
+	if spec.RetainLast > 0 {
+		d.Printf("Retain Last:\t%d\n", spec.RetainLast)
+	}
 
 	d.Println()
 	if len(spec.Hooks.Resources) == 0 {
@@ -348,6 +365,42 @@ func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, velero
 	}
 }
 
+func describeBackupResults(d *Describer, backup *velerov1api.Backup, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
+	if backup.Status.Warnings == 0 && backup.Status.Errors == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	var resultMap map[string]results.Result
+
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// backups taken prior to the results file being introduced won't have one
+			d.Println()
+			d.Println("Backup results:\t<backup results not found>")
+		} else {
+			d.Println()
+			d.Printf("Warnings:\t<error getting warnings: %v>\n\nErrors:\t<error getting errors: %v>\n", err, err)
+		}
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
+		d.Println()
+		d.Printf("Warnings:\t<error decoding warnings: %v>\n\nErrors:\t<error decoding errors: %v>\n", err, err)
+		return
+	}
+
+	if backup.Status.Warnings > 0 {
+		d.Println()
+		describeResult(d, "Warnings", resultMap["warnings"])
+	}
+	if backup.Status.Errors > 0 {
+		d.Println()
+		describeResult(d, "Errors", resultMap["errors"])
+	}
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
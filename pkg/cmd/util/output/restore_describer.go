@@ -30,7 +30,7 @@ import (
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
-	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
@@ -81,6 +81,10 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 
 		describeRestoreResults(d, restore, veleroClient, insecureSkipTLSVerify, caCertFile)
 
+		if details && restore.Status.Warnings > 0 {
+			describeRestoreDriftReport(d, restore, veleroClient, insecureSkipTLSVerify, caCertFile)
+		}
+
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
 
@@ -140,6 +144,16 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		if restore.Spec.ResourceModifiers != nil {
+			d.Println()
+			d.Printf("Resource modifiers:\t%s/%s\n", restore.Spec.ResourceModifiers.Kind, restore.Spec.ResourceModifiers.Name)
+		}
+
+		if restore.Spec.IdempotencyToken != "" {
+			d.Println()
+			d.Printf("Idempotency token:\t%s\n", restore.Spec.IdempotencyToken)
+		}
+
 	})
 }
 
@@ -149,7 +163,7 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 	}
 
 	var buf bytes.Buffer
-	var resultMap map[string]pkgrestore.Result
+	var resultMap map[string]results.Result
 
 	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestoreResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
 		d.Printf("Warnings:\t<error getting warnings: %v>\n\nErrors:\t<error getting errors: %v>\n", err, err)
@@ -163,15 +177,60 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 
 	if restore.Status.Warnings > 0 {
 		d.Println()
-		describeRestoreResult(d, "Warnings", resultMap["warnings"])
+		describeResult(d, "Warnings", resultMap["warnings"])
 	}
 	if restore.Status.Errors > 0 {
 		d.Println()
-		describeRestoreResult(d, "Errors", resultMap["errors"])
+		describeResult(d, "Errors", resultMap["errors"])
+	}
+}
+
+// describeRestoreDriftReport describes the items that weren't restored because
+// they already existed in the cluster with a different version than the
+// backed-up one, along with how they differ.
+func describeRestoreDriftReport(d *Describer, restore *v1.Restore, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
+	d.Println()
+
+	var buf bytes.Buffer
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestoreDriftReport, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// the drift report could be missing if the restore was run prior to
+			// drift reports being added, or if there was an error uploading it
+			d.Println("Drifted items:\t<restore drift report not found>")
+		} else {
+			d.Printf("Drifted items:\t<error getting restore drift report: %v>\n", err)
+		}
+		return
+	}
+
+	var items []results.ItemDrift
+	if err := json.NewDecoder(&buf).Decode(&items); err != nil {
+		d.Printf("Drifted items:\t<error reading restore drift report: %v>\n", err)
+		return
+	}
+
+	if len(items) == 0 {
+		d.Println("Drifted items:\t<none>")
+		return
+	}
+
+	d.Println("Drifted items (patch from the in-cluster version to the backed-up version):")
+	var resource string
+	for _, item := range items {
+		if item.Resource != resource {
+			resource = item.Resource
+			d.Printf("\t%s:\n", resource)
+		}
+
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		d.Printf("\t\t- %s: %s\n", name, string(item.Patch))
 	}
 }
 
-func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
+func describeResult(d *Describer, name string, result results.Result) {
 	d.Printf("%s:\n", name)
 	d.DescribeSlice(1, "Velero", result.Velero)
 	d.DescribeSlice(1, "Cluster", result.Cluster)
@@ -58,6 +58,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -428,6 +429,14 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the label selectors
+	if request.Spec.LabelSelector != nil && len(request.Spec.OrLabelSelectors) > 0 {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "labelSelector and orLabelSelectors can't both be specified")
+	}
+	if _, err := pkgbackup.GetLabelSelectors(&request.Spec); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid label selectors: %v", err))
+	}
+
 	return request
 }
 
@@ -636,6 +645,11 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
+	backupResults := map[string]results.Result{
+		"warnings": logCounter.GetEntries(logrus.WarnLevel),
+		"errors":   logCounter.GetEntries(logrus.ErrorLevel),
+	}
+
 	// Assign finalize phase as close to end as possible so that any errors
 	// logged to backupLog are captured. This is done before uploading the
 	// artifacts to object storage so that the JSON representation of the
@@ -657,7 +671,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
+	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents, backupResults); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
 	}
 
@@ -693,6 +707,7 @@ func persistBackup(backup *pkgbackup.Request,
 	log logrus.FieldLogger,
 	csiVolumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
 	csiVolumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
+	backupResults map[string]results.Result,
 ) []error {
 	persistErrs := []error{}
 	backupJSON := new(bytes.Buffer)
@@ -727,6 +742,11 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	backupResultsJSON, errs := encodeToJSONGzip(backupResults, "backup results")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -735,6 +755,7 @@ func persistBackup(backup *pkgbackup.Request,
 		backupResourceList = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
+		backupResultsJSON = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -747,6 +768,7 @@ func persistBackup(backup *pkgbackup.Request,
 		BackupResourceList:        backupResourceList,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
+		BackupResults:             backupResultsJSON,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
@@ -153,6 +153,15 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: foo"},
 		},
+		{
+			name: "label selector and or label selectors fails validation",
+			backup: defaultBackup().
+				LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+				OrLabelSelector([]*metav1.LabelSelector{{MatchLabels: map[string]string{"c": "d"}}}).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"labelSelector and orLabelSelectors can't both be specified"},
+		},
 		{
 			name:         "non-existent backup location fails validation",
 			backup:       defaultBackup().StorageLocation("nonexistent").Result(),
@@ -36,6 +36,7 @@ import (
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
@@ -80,6 +81,8 @@ type backupDeletionController struct {
 	backupStoreGetter         persistence.ObjectBackupStoreGetter
 	metrics                   *metrics.ServerMetrics
 	helper                    discovery.Helper
+	deletionPolicy            BackupDeletionPolicy
+	credentialStore           credentials.FileStore
 }
 
 // NewBackupDeletionController creates a new backup deletion controller.
@@ -102,6 +105,8 @@ func NewBackupDeletionController(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	helper discovery.Helper,
+	deletionPolicy BackupDeletionPolicy,
+	credentialStore credentials.FileStore,
 ) Interface {
 	c := &backupDeletionController{
 		genericController:         newGenericController(BackupDeletion, logger),
@@ -120,6 +125,8 @@ func NewBackupDeletionController(
 		csiSnapshotClient:         csiSnapshotClient,
 		metrics:                   metrics,
 		helper:                    helper,
+		deletionPolicy:            deletionPolicy,
+		credentialStore:           credentialStore,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
@@ -246,6 +253,15 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return err
 	}
 
+	// Don't allow deleting backups that the configured deletion policy protects
+	if policyErrs := c.deletionPolicy.Validate(backup); len(policyErrs) > 0 {
+		_, err := c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, policyErrs...)
+		})
+		return err
+	}
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -342,7 +358,7 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 
 				volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
 				if !ok {
-					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
+					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager, c.credentialStore); err != nil {
 						errs = append(errs, err.Error())
 						continue
 					}
@@ -451,6 +467,7 @@ func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	pluginManager clientmgmt.Manager,
+	credentialStore credentials.FileStore,
 ) (velero.VolumeSnapshotter, error) {
 	snapshotLocation, err := snapshotLocationLister.VolumeSnapshotLocations(namespace).Get(snapshotLocationName)
 	if err != nil {
@@ -462,7 +479,12 @@ func volumeSnapshotterForSnapshotLocation(
 		return nil, errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
 	}
 
-	if err = volumeSnapshotter.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentials(snapshotLocation.Spec.Config, snapshotLocation.Spec.CredentialProvider, snapshotLocation.Spec.Credential, credentialStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials for volume snapshot location %s", snapshotLocationName)
+	}
+
+	if err = volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", snapshotLocationName)
 	}
 
@@ -75,9 +75,11 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		nil, // csiSnapshotContentLister
 		nil, // csiSnapshotClient
 		nil, // new plugin manager func
-		persistence.NewObjectBackupStoreGetter(),
+		persistence.NewObjectBackupStoreGetter(nil),
 		metrics.NewServerMetrics(),
 		nil, // discovery helper
+		BackupDeletionPolicy{},
+		nil, // credentialStore
 	).(*backupDeletionController)
 
 	// Error splitting key
@@ -176,6 +178,8 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 			NewFakeSingleObjectBackupStoreGetter(backupStore),
 			metrics.NewServerMetrics(),
 			nil, // discovery helper
+			BackupDeletionPolicy{},
+			nil, // credentialStore
 		).(*backupDeletionController),
 
 		req: req,
@@ -430,6 +434,34 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("backup protected by the deletion policy", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.controller.deletionPolicy = BackupDeletionPolicy{ApprovalAnnotation: "velero.io/deletion-approved"}
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"errors":["backup is missing the deletion approval annotation velero.io/deletion-approved=true"],"phase":"Processed"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
 	t.Run("full delete, no errors", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
@@ -1131,9 +1163,11 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				nil, // csiSnapshotContentLister
 				nil, // csiSnapshotClient
 				nil, // new plugin manager func
-				persistence.NewObjectBackupStoreGetter(),
+				persistence.NewObjectBackupStoreGetter(nil),
 				metrics.NewServerMetrics(),
 				nil, // discovery helper,
+				BackupDeletionPolicy{},
+				nil, // credentialStore
 			).(*backupDeletionController)
 
 			fakeClock := &clock.FakeClock{}
@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// BackupDeletionPolicy defines the conditions that a backup must satisfy before
+// the backup deletion controller will honor a DeleteBackupRequest for it. The
+// conditions are checked against the Backup rather than the request so that
+// having create rights on DeleteBackupRequests is not enough to delete a backup.
+// The zero value allows all deletions.
+type BackupDeletionPolicy struct {
+	// AllowedNamespaces is a list of namespaces (globs are supported) that a
+	// backup may include and still be deleted. A backup that includes all
+	// namespaces can only be deleted if "*" is in the list. If empty, no
+	// namespace restrictions are applied.
+	AllowedNamespaces []string
+
+	// RequiredLabelSelector, if not empty, is a label selector that a backup's
+	// labels must match for the backup to be deleted.
+	RequiredLabelSelector labels.Selector
+
+	// ApprovalAnnotation, if set, is the name of an annotation that must be
+	// present on a backup with a value of "true" for the backup to be deleted.
+	ApprovalAnnotation string
+}
+
+// NewBackupDeletionPolicy returns a BackupDeletionPolicy built from its string
+// representation, as provided by the server's command-line flags.
+func NewBackupDeletionPolicy(allowedNamespaces []string, requiredLabelSelector, approvalAnnotation string) (BackupDeletionPolicy, error) {
+	policy := BackupDeletionPolicy{
+		AllowedNamespaces:  allowedNamespaces,
+		ApprovalAnnotation: approvalAnnotation,
+	}
+
+	if requiredLabelSelector != "" {
+		selector, err := labels.Parse(requiredLabelSelector)
+		if err != nil {
+			return BackupDeletionPolicy{}, errors.Wrapf(err, "error parsing backup deletion label selector %q", requiredLabelSelector)
+		}
+		policy.RequiredLabelSelector = selector
+	}
+
+	return policy, nil
+}
+
+// Validate returns a list of reasons why the policy does not allow the given
+// backup to be deleted, or an empty list if deletion is allowed.
+func (p BackupDeletionPolicy) Validate(backup *velerov1api.Backup) []string {
+	var errs []string
+
+	if len(p.AllowedNamespaces) > 0 {
+		allowed := collections.NewIncludesExcludes().Includes(p.AllowedNamespaces...)
+
+		namespaces := backup.Spec.IncludedNamespaces
+		if len(namespaces) == 0 {
+			namespaces = []string{"*"}
+		}
+
+		var disallowed []string
+		for _, ns := range namespaces {
+			// a wildcard include is only allowed by an explicit "*" in the allowlist,
+			// not by a glob that happens to match the "*" string.
+			if ns == "*" && !sets.NewString(p.AllowedNamespaces...).Has("*") {
+				disallowed = append(disallowed, ns)
+				continue
+			}
+			if !allowed.ShouldInclude(ns) {
+				disallowed = append(disallowed, ns)
+			}
+		}
+
+		if len(disallowed) > 0 {
+			errs = append(errs, fmt.Sprintf("backup includes namespaces that are not allowed to be deleted by policy: %s", strings.Join(disallowed, ", ")))
+		}
+	}
+
+	if p.RequiredLabelSelector != nil && !p.RequiredLabelSelector.Empty() && !p.RequiredLabelSelector.Matches(labels.Set(backup.Labels)) {
+		errs = append(errs, fmt.Sprintf("backup does not match the deletion policy's label selector %q", p.RequiredLabelSelector.String()))
+	}
+
+	if p.ApprovalAnnotation != "" && backup.Annotations[p.ApprovalAnnotation] != "true" {
+		errs = append(errs, fmt.Sprintf("backup is missing the deletion approval annotation %s=true", p.ApprovalAnnotation))
+	}
+
+	return errs
+}
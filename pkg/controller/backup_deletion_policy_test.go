@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestNewBackupDeletionPolicy(t *testing.T) {
+	policy, err := NewBackupDeletionPolicy([]string{"ns-1"}, "app=foo", "velero.io/deletion-approved")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1"}, policy.AllowedNamespaces)
+	assert.Equal(t, "app=foo", policy.RequiredLabelSelector.String())
+	assert.Equal(t, "velero.io/deletion-approved", policy.ApprovalAnnotation)
+
+	_, err = NewBackupDeletionPolicy(nil, "app in (", "")
+	assert.Error(t, err)
+}
+
+func TestBackupDeletionPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name              string
+		allowedNamespaces []string
+		labelSelector     string
+		annotation        string
+		backup            *velerov1api.Backup
+		expected          []string
+	}{
+		{
+			name:   "zero-value policy allows everything",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+		},
+		{
+			name:              "all included namespaces are allowed",
+			allowedNamespaces: []string{"ns-1", "dev-*"},
+			backup:            builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1", "dev-foo").Result(),
+		},
+		{
+			name:              "included namespace not in allowlist is rejected",
+			allowedNamespaces: []string{"ns-1"},
+			backup:            builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1", "ns-2").Result(),
+			expected:          []string{"backup includes namespaces that are not allowed to be deleted by policy: ns-2"},
+		},
+		{
+			name:              "backup of all namespaces is rejected without a wildcard in the allowlist",
+			allowedNamespaces: []string{"*-dev"},
+			backup:            builder.ForBackup("velero", "backup-1").Result(),
+			expected:          []string{"backup includes namespaces that are not allowed to be deleted by policy: *"},
+		},
+		{
+			name:              "backup of all namespaces is allowed with a wildcard in the allowlist",
+			allowedNamespaces: []string{"*"},
+			backup:            builder.ForBackup("velero", "backup-1").Result(),
+		},
+		{
+			name:          "backup matching label selector is allowed",
+			labelSelector: "retention=short",
+			backup:        builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels("retention", "short")).Result(),
+		},
+		{
+			name:          "backup not matching label selector is rejected",
+			labelSelector: "retention=short",
+			backup:        builder.ForBackup("velero", "backup-1").Result(),
+			expected:      []string{`backup does not match the deletion policy's label selector "retention=short"`},
+		},
+		{
+			name:       "backup with approval annotation is allowed",
+			annotation: "velero.io/deletion-approved",
+			backup:     builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithAnnotations("velero.io/deletion-approved", "true")).Result(),
+		},
+		{
+			name:       "backup with approval annotation not set to true is rejected",
+			annotation: "velero.io/deletion-approved",
+			backup:     builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithAnnotations("velero.io/deletion-approved", "no")).Result(),
+			expected:   []string{"backup is missing the deletion approval annotation velero.io/deletion-approved=true"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := NewBackupDeletionPolicy(test.allowedNamespaces, test.labelSelector, test.annotation)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, policy.Validate(test.backup))
+		})
+	}
+}
@@ -573,7 +573,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				nil, // kubeClient
 				"",
 				nil, // new plugin manager func
-				persistence.NewObjectBackupStoreGetter(),
+				persistence.NewObjectBackupStoreGetter(nil),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -667,7 +667,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				nil, // kubeClient
 				"",
 				nil, // new plugin manager func
-				persistence.NewObjectBackupStoreGetter(),
+				persistence.NewObjectBackupStoreGetter(nil),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
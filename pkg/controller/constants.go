@@ -28,6 +28,7 @@ const (
 	ResticRepo            = "restic-repo"
 	Restore               = "restore"
 	Schedule              = "schedule"
+	ServerConfig          = "server-config"
 	ServerStatusRequest   = "server-status-request"
 )
 
@@ -41,5 +42,6 @@ var DisableableControllers = []string{
 	ResticRepo,
 	Restore,
 	Schedule,
+	ServerConfig,
 	ServerStatusRequest,
 }
@@ -146,7 +146,7 @@ func (c *downloadRequestController) generatePreSignedURL(downloadRequest *velero
 	)
 
 	switch downloadRequest.Spec.Target.Kind {
-	case velerov1api.DownloadTargetKindRestoreLog, velerov1api.DownloadTargetKindRestoreResults:
+	case velerov1api.DownloadTargetKindRestoreLog, velerov1api.DownloadTargetKindRestoreResults, velerov1api.DownloadTargetKindRestoreDriftReport:
 		restore, err := c.restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
 		if err != nil {
 			return errors.Wrap(err, "error getting Restore")
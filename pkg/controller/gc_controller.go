@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -47,6 +48,7 @@ type gcController struct {
 	*genericController
 
 	backupLister              velerov1listers.BackupLister
+	scheduleLister            velerov1listers.ScheduleLister
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
 	kbClient                  client.Client
@@ -61,6 +63,7 @@ func NewGCController(
 	deleteBackupRequestLister velerov1listers.DeleteBackupRequestLister,
 	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
 	kbClient client.Client,
+	scheduleLister velerov1listers.ScheduleLister,
 ) Interface {
 	c := &gcController{
 		genericController:         newGenericController(GarbageCollection, logger),
@@ -69,6 +72,7 @@ func NewGCController(
 		deleteBackupRequestLister: deleteBackupRequestLister,
 		deleteBackupRequestClient: deleteBackupRequestClient,
 		kbClient:                  kbClient,
+		scheduleLister:            scheduleLister,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -134,6 +138,15 @@ func (c *gcController) processQueueItem(key string) error {
 
 	log.Info("Backup has expired")
 
+	retained, err := c.isRetainedBySchedule(backup)
+	if err != nil {
+		return err
+	}
+	if retained {
+		log.Infof("Backup is not garbage-collected because it is one of the most recent successful backups retained by schedule %s", backup.Labels[velerov1api.ScheduleNameLabel])
+		return nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: ns,
@@ -179,3 +192,68 @@ func (c *gcController) processQueueItem(key string) error {
 
 	return nil
 }
+
+// isRetainedBySchedule returns true if the backup was created by a schedule
+// that retains its most recent successful backups, and the backup is one of
+// them. The schedule's current RetainLast is used if the schedule still exists,
+// otherwise the value copied into the backup when it was created is used.
+func (c *gcController) isRetainedBySchedule(backup *velerov1api.Backup) (bool, error) {
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" || backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+		return false, nil
+	}
+
+	retainLast := backup.Spec.RetainLast
+	schedule, err := c.scheduleLister.Schedules(backup.Namespace).Get(scheduleName)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return false, errors.Wrap(err, "error getting schedule")
+	default:
+		retainLast = schedule.Spec.Template.RetainLast
+	}
+
+	if retainLast <= 0 {
+		return false, nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set(map[string]string{
+		velerov1api.ScheduleNameLabel: scheduleName,
+	}))
+
+	backups, err := c.backupLister.Backups(backup.Namespace).List(selector)
+	if err != nil {
+		return false, errors.Wrap(err, "error listing backups for schedule")
+	}
+
+	var successful []*velerov1api.Backup
+	for _, b := range backups {
+		if b.Status.Phase == velerov1api.BackupPhaseCompleted {
+			successful = append(successful, b)
+		}
+	}
+
+	startTime := func(b *velerov1api.Backup) time.Time {
+		if b.Status.StartTimestamp == nil {
+			return time.Time{}
+		}
+		return b.Status.StartTimestamp.Time
+	}
+
+	// sort from most to least recent
+	sort.Slice(successful, func(i, j int) bool {
+		ti, tj := startTime(successful[i]), startTime(successful[j])
+		if ti.Equal(tj) {
+			return successful[i].Name > successful[j].Name
+		}
+		return ti.After(tj)
+	})
+
+	for i := 0; i < retainLast && i < len(successful); i++ {
+		if successful[i].Name == backup.Name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -53,6 +53,7 @@ func TestGCControllerEnqueueAllBackups(t *testing.T) {
 			sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 			client.VeleroV1(),
 			nil,
+			sharedInformers.Velero().V1().Schedules().Lister(),
 		).(*gcController)
 	)
 
@@ -114,6 +115,7 @@ func TestGCControllerHasUpdateFunc(t *testing.T) {
 		sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 		client.VeleroV1(),
 		nil,
+		sharedInformers.Velero().V1().Schedules().Lister(),
 	).(*gcController)
 
 	keys := make(chan string)
@@ -158,6 +160,8 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		name                           string
 		backup                         *velerov1api.Backup
 		deleteBackupRequests           []*velerov1api.DeleteBackupRequest
+		otherBackups                   []*velerov1api.Backup
+		schedule                       *velerov1api.Schedule
 		backupLocation                 *velerov1api.BackupStorageLocation
 		expectDeletion                 bool
 		createDeleteBackupRequestError bool
@@ -232,6 +236,50 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			},
 			expectDeletion: true,
 		},
+		{
+			name:           "expired backup that is one of the most recent successful backups of its schedule is not deleted",
+			backup:         scheduledBackup("backup-1", fakeClock.Now().Add(-2*time.Hour), velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(-time.Second)).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Template(builder.ForBackup("", "").RetainLast(2).Result().Spec).Result(),
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("backup-2", fakeClock.Now().Add(-time.Hour), velerov1api.BackupPhaseCompleted).Result(),
+				scheduledBackup("backup-3", fakeClock.Now(), velerov1api.BackupPhaseFailed).Result(),
+			},
+			expectDeletion: false,
+		},
+		{
+			name:           "expired backup that is older than the most recent successful backups of its schedule is deleted",
+			backup:         scheduledBackup("backup-1", fakeClock.Now().Add(-2*time.Hour), velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(-time.Second)).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Template(builder.ForBackup("", "").RetainLast(1).Result().Spec).Result(),
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("backup-2", fakeClock.Now().Add(-time.Hour), velerov1api.BackupPhaseCompleted).Result(),
+			},
+			expectDeletion: true,
+		},
+		{
+			name:           "expired backup uses the schedule's current retain last count instead of its own",
+			backup:         scheduledBackup("backup-1", fakeClock.Now().Add(-2*time.Hour), velerov1api.BackupPhaseCompleted).RetainLast(5).Expiration(fakeClock.Now().Add(-time.Second)).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Result(),
+			expectDeletion: true,
+		},
+		{
+			name:           "expired backup of a deleted schedule uses its own retain last count",
+			backup:         scheduledBackup("backup-1", fakeClock.Now().Add(-2*time.Hour), velerov1api.BackupPhaseCompleted).RetainLast(1).Expiration(fakeClock.Now().Add(-time.Second)).Result(),
+			backupLocation: defaultBackupLocation,
+			otherBackups: []*velerov1api.Backup{
+				scheduledBackup("backup-2", fakeClock.Now().Add(-time.Hour), velerov1api.BackupPhaseFailed).Result(),
+			},
+			expectDeletion: false,
+		},
+		{
+			name:           "expired failed backup of a schedule that retains backups is deleted",
+			backup:         scheduledBackup("backup-1", fakeClock.Now().Add(-2*time.Hour), velerov1api.BackupPhaseFailed).Expiration(fakeClock.Now().Add(-time.Second)).Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "daily").Template(builder.ForBackup("", "").RetainLast(2).Result().Spec).Result(),
+			expectDeletion: true,
+		},
 		{
 			name:                           "create DeleteBackupRequest error returns an error",
 			backup:                         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -262,6 +310,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Lister(),
 				client.VeleroV1(),
 				fakeClient,
+				sharedInformers.Velero().V1().Schedules().Lister(),
 			).(*gcController)
 			controller.clock = fakeClock
 
@@ -271,6 +320,14 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup)
 			}
 
+			for _, backup := range test.otherBackups {
+				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup)
+			}
+
+			if test.schedule != nil {
+				sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule)
+			}
+
 			for _, dbr := range test.deleteBackupRequests {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(dbr)
 			}
@@ -298,3 +355,11 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 		})
 	}
 }
+
+func scheduledBackup(name string, startTime time.Time, phase velerov1api.BackupPhase) *builder.BackupBuilder {
+	return builder.ForBackup(velerov1api.DefaultNamespace, name).
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+		StorageLocation("default").
+		StartTimestamp(startTime).
+		Phase(phase)
+}
@@ -36,8 +36,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -51,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -90,6 +93,7 @@ type restoreController struct {
 	restoreLogLevel        logrus.Level
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
+	configMapClient        corev1client.ConfigMapsGetter
 	clock                  clock.Clock
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
@@ -111,6 +115,7 @@ func NewRestoreController(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	configMapClient corev1client.ConfigMapsGetter,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController(Restore, logger),
@@ -125,6 +130,7 @@ func NewRestoreController(
 		restoreLogLevel:        restoreLogLevel,
 		metrics:                metrics,
 		logFormat:              logFormat,
+		configMapClient:        configMapClient,
 		clock:                  &clock.RealClock{},
 
 		// use variables to refer to these functions so they can be
@@ -283,6 +289,10 @@ type backupInfo struct {
 	backup      *api.Backup
 	location    *velerov1api.BackupStorageLocation
 	backupStore persistence.BackupStore
+
+	// resourceModifiers are the parsed contents of the config map
+	// referenced by the restore's spec.resourceModifiers, if any.
+	resourceModifiers *resourcemodifiers.ResourceModifiers
 }
 
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
@@ -312,6 +322,24 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the resource modifiers reference; the config map itself is fetched once the backup is known
+	if restore.Spec.ResourceModifiers != nil && restore.Spec.ResourceModifiers.Kind != "ConfigMap" {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid resource modifiers kind %q, must be ConfigMap", restore.Spec.ResourceModifiers.Kind))
+	}
+
+	// reject restores that duplicate an earlier request with the same idempotency token
+	if restore.Spec.IdempotencyToken != "" {
+		original, err := c.findOriginalRestore(restore)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error checking for restores with the same idempotency token: %v", err))
+			return backupInfo{}
+		}
+		if original != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Restore %s has the same idempotency token %q; this restore is a duplicate of it", original.Name, restore.Spec.IdempotencyToken))
+			return backupInfo{}
+		}
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -353,11 +381,64 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	if restore.Spec.ResourceModifiers != nil && restore.Spec.ResourceModifiers.Kind == "ConfigMap" {
+		modifiers, err := c.getResourceModifiers(restore)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving resource modifiers: %v", err))
+			return backupInfo{}
+		}
+		info.resourceModifiers = modifiers
+	}
+
 	return info
 }
 
+// getResourceModifiers fetches and parses the config map referenced by the
+// restore's spec.resourceModifiers.
+func (c *restoreController) getResourceModifiers(restore *api.Restore) (*resourcemodifiers.ResourceModifiers, error) {
+	cm, err := c.configMapClient.ConfigMaps(restore.Namespace).Get(context.TODO(), restore.Spec.ResourceModifiers.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return resourcemodifiers.GetResourceModifiersFromConfig(cm)
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
+// findOriginalRestore returns the restore that the given restore duplicates, if any. Of all
+// the restores with the same idempotency token that have not failed validation, the one
+// created first (by name, for restores created in the same second) is the original, so that
+// two restores created concurrently with the same token can't both run.
+func (c *restoreController) findOriginalRestore(restore *api.Restore) (*api.Restore, error) {
+	restores, err := c.restoreLister.Restores(restore.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var original *api.Restore
+	for _, r := range restores {
+		if r.Name == restore.Name || r.Spec.IdempotencyToken != restore.Spec.IdempotencyToken || r.Status.Phase == api.RestorePhaseFailedValidation {
+			continue
+		}
+		if !createdBefore(r, restore) {
+			continue
+		}
+		if original == nil || createdBefore(r, original) {
+			original = r
+		}
+	}
+
+	return original, nil
+}
+
+func createdBefore(a, b *api.Restore) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
 func backupXorScheduleProvided(restore *api.Restore) bool {
 	if restore.Spec.BackupName != "" && restore.Spec.ScheduleName != "" {
 		return false
@@ -424,7 +505,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 }
 
 // runValidatedRestore takes a validated restore API object and executes the restore process.
-// The log and results files are uploaded to backup storage. Any error returned from this function
+// The log, results and drift report files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
 func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
@@ -468,13 +549,16 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	for i := range podVolumeBackupList.Items {
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
+	driftReport := new(results.DriftReport)
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:               restoreLog,
+		Restore:           restore,
+		Backup:            info.backup,
+		PodVolumeBackups:  podVolumeBackups,
+		VolumeSnapshots:   volumeSnapshots,
+		BackupReader:      backupFile,
+		ResourceModifiers: info.resourceModifiers,
+		DriftReport:       driftReport,
 	}
 	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
 	restoreLog.Info("restore completed")
@@ -516,6 +600,10 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if err := putDriftReport(restore, driftReport.Items(), info.backupStore); err != nil {
+		c.logger.WithError(err).Error("Error uploading restore drift report to backup storage")
+	}
+
 	return nil
 }
 
@@ -539,6 +627,26 @@ func putResults(restore *api.Restore, results map[string]pkgrestore.Result, back
 	return nil
 }
 
+func putDriftReport(restore *api.Restore, items []results.ItemDrift, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding restore drift report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestoreDriftReport(restore.Spec.BackupName, restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
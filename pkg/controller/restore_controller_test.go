@@ -120,6 +120,7 @@ func TestFetchBackupInfo(t *testing.T) {
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil, // configMapClient
 			).(*restoreController)
 
 			if test.backupStoreError == nil {
@@ -209,9 +210,10 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				logger,
 				logrus.InfoLevel,
 				nil,
-				persistence.NewObjectBackupStoreGetter(),
+				persistence.NewObjectBackupStoreGetter(nil),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil, // configMapClient
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -272,6 +274,15 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with resource modifiers that are not a config map fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceModifiers("Secret", "modifiers").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid resource modifiers kind \"Secret\", must be ConfigMap"},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -439,6 +450,7 @@ func TestProcessQueueItem(t *testing.T) {
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil, // configMapClient
 			).(*restoreController)
 
 			c.clock = clock.NewFakeClock(now)
@@ -512,6 +524,8 @@ func TestProcessQueueItem(t *testing.T) {
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 
+				backupStore.On("PutRestoreDriftReport", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+
 				volumeSnapshots := []*volume.Snapshot{
 					{
 						Spec: volume.SnapshotSpec{
@@ -666,9 +680,10 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		logger,
 		logrus.DebugLevel,
 		nil,
-		persistence.NewObjectBackupStoreGetter(),
+		persistence.NewObjectBackupStoreGetter(nil),
 		nil,
 		formatFlag,
+		nil, // configMapClient
 	).(*restoreController)
 
 	restore := &velerov1api.Restore{
@@ -754,6 +769,92 @@ func TestBackupXorScheduleProvided(t *testing.T) {
 	assert.True(t, backupXorScheduleProvided(r))
 }
 
+func TestFindOriginalRestore(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		existing []*velerov1api.Restore
+		expected string
+	}{
+		{
+			name:    "no other restores with the token",
+			restore: builder.ForRestore("velero", "restore-2").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-1").IdempotencyToken("other-token").ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).Result(),
+				builder.ForRestore("velero", "restore-3").ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).Result(),
+			},
+		},
+		{
+			name:    "earlier in-progress restore with the token is the original",
+			restore: builder.ForRestore("velero", "restore-2").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-1").IdempotencyToken("token").Phase(velerov1api.RestorePhaseInProgress).ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).Result(),
+			},
+			expected: "restore-1",
+		},
+		{
+			name:    "earliest of several restores with the token is the original",
+			restore: builder.ForRestore("velero", "restore-3").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-2").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Second))).Result(),
+				builder.ForRestore("velero", "restore-1").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).Result(),
+			},
+			expected: "restore-1",
+		},
+		{
+			name:    "restores created at the same time are ordered by name",
+			restore: builder.ForRestore("velero", "restore-b").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-a").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			},
+			expected: "restore-a",
+		},
+		{
+			name:    "later restore with the token is not the original",
+			restore: builder.ForRestore("velero", "restore-1").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-2").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now.Add(time.Second))).Result(),
+			},
+		},
+		{
+			name:    "earlier restore that failed validation is ignored",
+			restore: builder.ForRestore("velero", "restore-2").IdempotencyToken("token").ObjectMeta(builder.WithCreationTimestamp(now)).Result(),
+			existing: []*velerov1api.Restore{
+				builder.ForRestore("velero", "restore-1").IdempotencyToken("token").Phase(velerov1api.RestorePhaseFailedValidation).ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).Result(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+			)
+
+			c := &restoreController{
+				restoreLister: sharedInformers.Velero().V1().Restores().Lister(),
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(test.restore))
+			for _, r := range test.existing {
+				require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(r))
+			}
+
+			original, err := c.findOriginalRestore(test.restore)
+			require.NoError(t, err)
+			if test.expected == "" {
+				assert.Nil(t, original)
+			} else {
+				require.NotNil(t, original)
+				assert.Equal(t, test.expected, original.Name)
+			}
+		})
+	}
+}
+
 func TestMostRecentCompletedBackup(t *testing.T) {
 	backups := []*velerov1api.Backup{
 		{
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ServerConfigLogLevelKey is the key in the server config map whose value,
+// if set, overrides the server's log level.
+const ServerConfigLogLevelKey = "log-level"
+
+// serverConfigController watches the server config map and applies the
+// settings in it to the running server, so they can be changed without
+// restarting the server and interrupting in-flight backups and restores.
+type serverConfigController struct {
+	*genericController
+
+	namespace       string
+	name            string
+	configMapLister corev1listers.ConfigMapLister
+	serverLogger    *logrus.Logger
+	defaultLogLevel logrus.Level
+}
+
+// NewServerConfigController creates a controller that applies the settings in the
+// config map with the given namespace and name to the server. configMapInformer
+// should be filtered to that config map. When a setting is removed from the config
+// map, or the config map is deleted, the setting reverts to its startup value.
+func NewServerConfigController(
+	logger logrus.FieldLogger,
+	namespace string,
+	name string,
+	configMapInformer cache.SharedIndexInformer,
+	serverLogger *logrus.Logger,
+) Interface {
+	c := &serverConfigController{
+		genericController: newGenericController(ServerConfig, logger),
+		namespace:         namespace,
+		name:              name,
+		configMapLister:   corev1listers.NewConfigMapLister(configMapInformer.GetIndexer()),
+		serverLogger:      serverLogger,
+		defaultLogLevel:   serverLogger.GetLevel(),
+	}
+
+	c.syncHandler = c.processQueueItem
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, configMapInformer.HasSynced)
+
+	// there's only one config map of interest, so always enqueue its key; this
+	// also avoids having to handle tombstones on delete.
+	enqueueConfig := func(interface{}) { c.queue.Add(namespace + "/" + name) }
+	configMapInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    enqueueConfig,
+			UpdateFunc: func(_, obj interface{}) { enqueueConfig(obj) },
+			DeleteFunc: enqueueConfig,
+		},
+	)
+
+	return c
+}
+
+func (c *serverConfigController) processQueueItem(_ string) error {
+	log := c.logger.WithField("configMap", c.namespace+"/"+c.name)
+
+	var data map[string]string
+	configMap, err := c.configMapLister.ConfigMaps(c.namespace).Get(c.name)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Debug("Server config map not found, using startup settings")
+	case err != nil:
+		return errors.Wrap(err, "error getting server config map")
+	default:
+		data = configMap.Data
+	}
+
+	c.applyLogLevel(log, data[ServerConfigLogLevelKey])
+
+	return nil
+}
+
+// applyLogLevel sets the server's log level to the provided value, or to its
+// startup value if the provided value is empty or invalid.
+func (c *serverConfigController) applyLogLevel(log logrus.FieldLogger, value string) {
+	level := c.defaultLogLevel
+	if value != "" {
+		parsed, err := logrus.ParseLevel(strings.TrimSpace(value))
+		if err != nil {
+			log.WithError(err).Errorf("Invalid %s in server config map, using the startup log level %s", ServerConfigLogLevelKey, c.defaultLogLevel)
+		} else {
+			level = parsed
+		}
+	}
+
+	if level == c.serverLogger.GetLevel() {
+		return
+	}
+
+	log.Infof("Changing server log level from %s to %s", c.serverLogger.GetLevel(), level)
+	c.serverLogger.SetLevel(level)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestServerConfigControllerProcessQueueItem(t *testing.T) {
+	tests := []struct {
+		name      string
+		configMap *corev1api.ConfigMap
+		want      logrus.Level
+	}{
+		{
+			name: "missing config map uses the startup log level",
+			want: logrus.InfoLevel,
+		},
+		{
+			name:      "config map without a log level uses the startup log level",
+			configMap: serverConfigMap("velero", "velero-server-config", map[string]string{"foo": "bar"}),
+			want:      logrus.InfoLevel,
+		},
+		{
+			name:      "valid log level is applied",
+			configMap: serverConfigMap("velero", "velero-server-config", map[string]string{ServerConfigLogLevelKey: "debug"}),
+			want:      logrus.DebugLevel,
+		},
+		{
+			name:      "log level with surrounding whitespace is applied",
+			configMap: serverConfigMap("velero", "velero-server-config", map[string]string{ServerConfigLogLevelKey: " warning\n"}),
+			want:      logrus.WarnLevel,
+		},
+		{
+			name:      "invalid log level uses the startup log level",
+			configMap: serverConfigMap("velero", "velero-server-config", map[string]string{ServerConfigLogLevelKey: "loud"}),
+			want:      logrus.InfoLevel,
+		},
+		{
+			name:      "config map with a different name is ignored",
+			configMap: serverConfigMap("velero", "other", map[string]string{ServerConfigLogLevelKey: "debug"}),
+			want:      logrus.InfoLevel,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serverLogger := logrus.New()
+			serverLogger.SetLevel(logrus.InfoLevel)
+
+			sharedInformers := kubeinformers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+			configMapInformer := sharedInformers.Core().V1().ConfigMaps().Informer()
+
+			c := NewServerConfigController(
+				velerotest.NewLogger(),
+				"velero",
+				"velero-server-config",
+				configMapInformer,
+				serverLogger,
+			).(*serverConfigController)
+
+			// start from a non-default level so reverting is observable
+			serverLogger.SetLevel(logrus.ErrorLevel)
+
+			if test.configMap != nil {
+				require.NoError(t, configMapInformer.GetStore().Add(test.configMap))
+			}
+
+			require.NoError(t, c.processQueueItem("velero/velero-server-config"))
+			assert.Equal(t, test.want, serverLogger.GetLevel())
+		})
+	}
+}
+
+func serverConfigMap(namespace, name string, data map[string]string) *corev1api.ConfigMap {
+	return &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Data:       data,
+	}
+}
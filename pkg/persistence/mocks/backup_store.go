@@ -274,6 +274,20 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoreDriftReport provides a mock function with given fields: backup, restore, report
+func (_m *BackupStore) PutRestoreDriftReport(backup string, restore string, report io.Reader) error {
+	ret := _m.Called(backup, restore, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1beta1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
@@ -29,6 +29,7 @@ import (
 	"github.com/sirupsen/logrus"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -44,7 +45,8 @@ type BackupInfo struct {
 	VolumeSnapshots,
 	BackupResourceList,
 	CSIVolumeSnapshots,
-	CSIVolumeSnapshotContents io.Reader
+	CSIVolumeSnapshotContents,
+	BackupResults io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -69,6 +71,7 @@ type BackupStore interface {
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	PutRestoreDriftReport(backup, restore string, report io.Reader) error
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
@@ -96,12 +99,15 @@ type ObjectBackupStoreGetter interface {
 	Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error)
 }
 
-type objectBackupStoreGetter struct{}
+type objectBackupStoreGetter struct {
+	credentialStore credentials.FileStore
+}
 
 // NewObjectBackupStoreGetter returns a ObjectBackupStoreGetter that can get a
-// default velero.BackupStore.
-func NewObjectBackupStoreGetter() ObjectBackupStoreGetter {
-	return &objectBackupStoreGetter{}
+// default velero.BackupStore. credentialStore is used to fetch the credentials
+// of locations that specify them, and may be nil if none do.
+func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBackupStoreGetter {
+	return &objectBackupStoreGetter{credentialStore: credentialStore}
 }
 
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
@@ -139,12 +145,19 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		}
 	}
 
+	// if the location has its own credentials, fetch them and pass the path of
+	// the file they're written to in the config so the plugin can use them.
+	config, err := credentials.ConfigWithCredentials(location.Spec.Config, location.Spec.CredentialProvider, location.Spec.Credential, b.credentialStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials for backup storage location %s", location.Name)
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := objectStore.Init(location.Spec.Config); err != nil {
+	if err := objectStore.Init(config); err != nil {
 		return nil, err
 	}
 
@@ -242,6 +255,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
+		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
 	}
 
 	for key, reader := range backupObjs {
@@ -451,6 +465,10 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) PutRestoreDriftReport(backup string, restore string, report io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreDriftReportKey(restore), report)
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
@@ -461,10 +479,14 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreDriftReport:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreDriftReportKey(target.Name), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -222,6 +223,7 @@ func TestPutBackup(t *testing.T) {
 		podVolumeBackup io.Reader
 		snapshots       io.Reader
 		resourceList    io.Reader
+		results         io.Reader
 		expectedErr     string
 		expectedKeys    []string
 	}{
@@ -233,6 +235,7 @@ func TestPutBackup(t *testing.T) {
 			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
 			snapshots:       newStringReadSeeker("snapshots"),
 			resourceList:    newStringReadSeeker("resourceList"),
+			results:         newStringReadSeeker("results"),
 			expectedErr:     "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -241,6 +244,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-podvolumebackups.json.gz",
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-results.gz",
 			},
 		},
 		{
@@ -325,6 +329,7 @@ func TestPutBackup(t *testing.T) {
 				PodVolumeBackups:   tc.podVolumeBackup,
 				VolumeSnapshots:    tc.snapshots,
 				BackupResourceList: tc.resourceList,
+				BackupResults:      tc.results,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -505,6 +510,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupResults:         "backups/my-backup/my-backup-results.gz",
 			},
 		},
 		{
@@ -516,6 +522,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupLog:             "velero-backups/backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupResults:         "velero-backups/backups/my-backup/my-backup-results.gz",
 			},
 		},
 		{
@@ -553,8 +560,9 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "restore",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:         "restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:     "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreDriftReport: "restores/my-backup/restore-my-backup-drift-report.json.gz",
 			},
 		},
 		{
@@ -562,16 +570,18 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:         "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:     "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreDriftReport: "velero-backups/restores/my-backup/restore-my-backup-drift-report.json.gz",
 			},
 		},
 		{
 			name:       "restore with multiple dashes",
 			targetName: "b-cool-20170913154901-20170913154902",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:         "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:     "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestoreDriftReport: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-drift-report.json.gz",
 			},
 		},
 	}
@@ -631,6 +641,15 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("invalid/bucket").Result(),
 			wantErr:  "backup storage location's bucket name \"invalid/bucket\" must not contain a '/' (if using a prefix, put it in the 'Prefix' field instead)",
 		},
+		{
+			name: "location with a Credential and no credential store results in an error",
+			location: builder.ForBackupStorageLocation("", "loc-1").Provider("provider-1").Bucket("bucket").
+				Credential(&corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "creds"}, Key: "cloud"}).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			wantErr: "error getting credentials for backup storage location loc-1: a credential is specified but no credential store is configured",
+		},
 		{
 			name:     "when Bucket has a leading and trailing slash, they are both stripped",
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("/bucket/").Result(),
@@ -661,7 +680,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			getter := NewObjectBackupStoreGetter()
+			getter := NewObjectBackupStoreGetter(nil)
 			res, err := getter.Get(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.Equal(t, tc.wantErr, err.Error())
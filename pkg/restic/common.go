@@ -195,6 +195,25 @@ func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool) [
 	return podVolumes
 }
 
+// GetPodPVCVolumesToMove returns the names of the provided pod's volumes that use a
+// persistent volume claim, excluding any volumes that are opted out of restic backup.
+// These are the volumes whose contents are copied with restic when a backup moves
+// volume data instead of taking native snapshots.
+func GetPodPVCVolumesToMove(pod *corev1api.Pod) []string {
+	volsToExclude := getVolumesToExclude(pod)
+	var podVolumes []string
+	for _, pv := range pod.Spec.Volumes {
+		if pv.PersistentVolumeClaim == nil {
+			continue
+		}
+		if contains(volsToExclude, pv.Name) {
+			continue
+		}
+		podVolumes = append(podVolumes, pv.Name)
+	}
+	return podVolumes
+}
+
 // SnapshotIdentifier uniquely identifies a restic snapshot
 // taken by Velero.
 type SnapshotIdentifier struct {
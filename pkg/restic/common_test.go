@@ -564,6 +564,37 @@ func TestGetPodVolumesUsingRestic(t *testing.T) {
 	}
 }
 
+func TestGetPodPVCVolumesToMove(t *testing.T) {
+	pvcVolume := func(name string) corev1api.Volume {
+		return corev1api.Volume{
+			Name: name,
+			VolumeSource: corev1api.VolumeSource{
+				PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: name + "-claim"},
+			},
+		}
+	}
+
+	pod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				VolumesToExcludeAnnotation: "excludedPVC",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			Volumes: []corev1api.Volume{
+				pvcVolume("pvc1"),
+				pvcVolume("pvc2"),
+				pvcVolume("excludedPVC"),
+				{Name: "scratch", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}},
+				{Name: "appConfig", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: "app-config"}}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"pvc1", "pvc2"}, GetPodPVCVolumesToMove(pod))
+	assert.Empty(t, GetPodPVCVolumesToMove(&corev1api.Pod{}))
+}
+
 func TestIsPVBMatchPod(t *testing.T) {
 	testCases := []struct {
 		name     string
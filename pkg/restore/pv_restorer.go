@@ -21,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
@@ -39,6 +40,7 @@ type pvRestorer struct {
 	volumeSnapshots         []*volume.Snapshot
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
+	credentialStore         credentials.FileStore
 }
 
 func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -73,7 +75,12 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 		return nil, errors.WithStack(err)
 	}
 
-	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentials(snapshotInfo.location.Spec.Config, snapshotInfo.location.Spec.CredentialProvider, snapshotInfo.location.Spec.Credential, r.credentialStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials for volume snapshot location %s", snapshotInfo.location.Name)
+	}
+
+	if err := volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -44,7 +44,9 @@ import (
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -59,6 +61,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -82,6 +85,14 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// ResourceModifiers, if not nil, are applied to each item before it is created.
+	ResourceModifiers *resourcemodifiers.ResourceModifiers
+
+	// DriftReport, if not nil, is populated with the differences between the
+	// in-cluster and backed-up versions of items that weren't restored because
+	// they already existed.
+	DriftReport *results.DriftReport
 }
 
 // Restorer knows how to restore a backup.
@@ -108,6 +119,8 @@ type kubernetesRestorer struct {
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
 	podGetter                  cache.Getter
+	itemWorkers                int
+	credentialStore            credentials.FileStore
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -122,6 +135,8 @@ func NewKubernetesRestorer(
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
 	podGetter cache.Getter,
+	itemWorkers int,
+	credentialStore credentials.FileStore,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		discoveryHelper:            discoveryHelper,
@@ -143,6 +158,8 @@ func NewKubernetesRestorer(
 		fileSystem:         filesystem.NewFileSystem(),
 		podCommandExecutor: podCommandExecutor,
 		podGetter:          podGetter,
+		itemWorkers:        itemWorkers,
+		credentialStore:    credentialStore,
 	}, nil
 }
 
@@ -223,6 +240,7 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:         req.VolumeSnapshots,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		snapshotLocationLister:  snapshotLocationLister,
+		credentialStore:         kr.credentialStore,
 	}
 
 	restoreCtx := &restoreContext{
@@ -256,6 +274,9 @@ func (kr *kubernetesRestorer) Restore(
 		waitExecHookHandler:        waitExecHookHandler,
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
+		itemWorkers:                kr.itemWorkers,
+		resourceModifiers:          req.ResourceModifiers,
+		driftReport:                req.DriftReport,
 	}
 
 	return restoreCtx.execute()
@@ -335,6 +356,13 @@ type restoreContext struct {
 	waitExecHookHandler        hook.WaitExecHookHandler
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	resourceModifiers          *resourcemodifiers.ResourceModifiers
+	driftReport                *results.DriftReport
+	itemWorkers                int
+
+	// lock guards the maps and sets above that are updated while
+	// restoring items, since items may be restored concurrently.
+	lock sync.Mutex
 }
 
 type resourceClientKey struct {
@@ -732,33 +760,75 @@ func (ctx *restoreContext) restoreResource(resource, targetNamespace, originalNa
 
 	groupResource := schema.ParseGroupResource(resource)
 
-	for _, item := range items {
-		itemPath := archive.GetItemFilePath(ctx.restoreDir, resource, originalNamespace, item)
+	// persistent volumes are always restored one at a time since restoring
+	// them may involve checking for and creating volumes from snapshots.
+	workers := ctx.itemWorkers
+	if workers < 1 || groupResource == kuberesource.PersistentVolumes {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
 
-		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
-		if err != nil {
-			errs.Add(targetNamespace, fmt.Errorf("error decoding %q: %v", strings.Replace(itemPath, ctx.restoreDir+"/", "", -1), err))
-			continue
-		}
+	var (
+		resultsLock sync.Mutex
+		wg          sync.WaitGroup
+		itemsChan   = make(chan string)
+	)
 
-		if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
-			continue
-		}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for item := range itemsChan {
+				w, e := ctx.restoreResourceItem(groupResource, targetNamespace, originalNamespace, item)
 
-		w, e := ctx.restoreItem(obj, groupResource, targetNamespace)
-		warnings.Merge(&w)
-		errs.Merge(&e)
+				resultsLock.Lock()
+				warnings.Merge(&w)
+				errs.Merge(&e)
+				resultsLock.Unlock()
+			}
+		}()
 	}
 
+	for _, item := range items {
+		itemsChan <- item
+	}
+	close(itemsChan)
+	wg.Wait()
+
 	return warnings, errs
 }
 
+// restoreResourceItem decodes a single item of the given resource from the backup
+// and restores it if it matches the restore's label selector.
+func (ctx *restoreContext) restoreResourceItem(groupResource schema.GroupResource, targetNamespace, originalNamespace, item string) (Result, Result) {
+	itemPath := archive.GetItemFilePath(ctx.restoreDir, groupResource.String(), originalNamespace, item)
+
+	obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+	if err != nil {
+		errs := Result{}
+		errs.Add(targetNamespace, fmt.Errorf("error decoding %q: %v", strings.Replace(itemPath, ctx.restoreDir+"/", "", -1), err))
+		return Result{}, errs
+	}
+
+	if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+		return Result{}, Result{}
+	}
+
+	return ctx.restoreItem(obj, groupResource, targetNamespace)
+}
+
 func (ctx *restoreContext) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
 	key := resourceClientKey{
 		resource:  groupResource.WithVersion(obj.GroupVersionKind().Version),
 		namespace: namespace,
 	}
 
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
 	if client, ok := ctx.resourceClients[key]; ok {
 		return client, nil
 	}
@@ -862,11 +932,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		Namespace:     namespace,
 		Name:          name,
 	}
+	ctx.lock.Lock()
 	if _, exists := ctx.restoredItems[itemKey]; exists {
+		ctx.lock.Unlock()
 		ctx.log.Infof("Skipping %s because it's already been restored.", resourceID)
 		return warnings, errs
 	}
 	ctx.restoredItems[itemKey] = struct{}{}
+	ctx.lock.Unlock()
 
 	// TODO: move to restore item action if/when we add a ShouldRestore() method to the interface
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
@@ -947,7 +1020,9 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					pvName = obj.GetName()
 				}
 
+				ctx.lock.Lock()
 				ctx.renamedPVs[oldName] = pvName
+				ctx.lock.Unlock()
 				obj.SetName(pvName)
 
 				// add the original PV name as an annotation
@@ -961,14 +1036,18 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
+			ctx.lock.Lock()
 			ctx.pvsToProvision.Insert(name)
+			ctx.lock.Unlock()
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
 
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
+			ctx.lock.Lock()
 			ctx.pvsToProvision.Insert(name)
+			ctx.lock.Unlock()
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
@@ -1071,19 +1150,24 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
+		ctx.lock.Lock()
+		shouldProvision := ctx.pvsToProvision.Has(pvc.Spec.VolumeName)
+		newName, renamed := ctx.renamedPVs[pvc.Spec.VolumeName]
+		ctx.lock.Unlock()
+
 		if pvc.Spec.VolumeName != "" {
 			// This used to only happen with restic volumes, but now always remove this binding metadata
 			obj = resetVolumeBindingInfo(obj)
 
 			// This is the case for restic volumes, where we need to actually have an empty volume created instead of restoring one.
 			// The assumption is that any PV in pvsToProvision doesn't have an associated snapshot.
-			if ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
+			if shouldProvision {
 				ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for dynamic provisioning", namespace, name)
 				unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
 			}
 		}
 
-		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
+		if renamed {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
 				errs.Add(namespace, err)
@@ -1099,6 +1183,15 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	if ctx.resourceModifiers != nil {
+		if modifierErrs := ctx.resourceModifiers.ApplyResourceModifierRules(obj, ctx.log.WithField("name", kube.NamespaceAndName(obj))); len(modifierErrs) > 0 {
+			for _, err := range modifierErrs {
+				errs.Add(namespace, err)
+			}
+			return warnings, errs
+		}
+	}
+
 	// label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from
@@ -1157,6 +1250,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			default:
 				e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
 				warnings.Add(namespace, e)
+				ctx.recordDrift(groupResource, fromCluster, obj)
 			}
 			return warnings, errs
 		}
@@ -1404,6 +1498,31 @@ func resetVolumeBindingInfo(obj *unstructured.Unstructured) *unstructured.Unstru
 	return obj
 }
 
+// recordDrift adds the differences between the in-cluster and backed-up
+// versions of an item that wasn't restored because it already exists to the
+// restore's drift report, if there is one.
+func (ctx *restoreContext) recordDrift(groupResource schema.GroupResource, fromCluster, obj *unstructured.Unstructured) {
+	if ctx.driftReport == nil {
+		return
+	}
+
+	patch, err := generatePatch(fromCluster, obj)
+	if err != nil {
+		ctx.log.Infof("Error generating drift report entry for %s: %v", kube.NamespaceAndName(obj), err)
+		return
+	}
+	if patch == nil {
+		return
+	}
+
+	ctx.driftReport.Add(results.ItemDrift{
+		Resource:  groupResource.String(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Patch:     patch,
+	})
+}
+
 func resetMetadataAndStatus(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	res, ok := obj.Object["metadata"]
 	if !ok {
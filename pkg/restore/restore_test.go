@@ -52,6 +52,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -640,6 +641,86 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
 // resource identifiers as they're created, and comparing that to the expected order.
+// TestRestoreWithItemWorkers runs restores with multiple item workers and verifies
+// that every item is restored exactly once.
+func TestRestoreWithItemWorkers(t *testing.T) {
+	var pods []metav1.Object
+	var want []string
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("pod-%d", i)
+		pods = append(pods, builder.ForPod("ns-1", name).Result())
+		want = append(want, "ns-1/"+name)
+	}
+
+	for _, workers := range []int{0, 1, 4, 50} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.itemWorkers = workers
+
+			h.DiscoveryClient.WithAPIResource(test.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      defaultRestore().Result(),
+				Backup:       defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("pods", pods...).Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, map[*test.APIResource][]string{
+				test.Pods(): want,
+			})
+		})
+	}
+}
+
+// TestRestoreDriftReport runs restores of items that already exist in the
+// cluster and verifies that only items whose in-cluster version differs from
+// the backed-up version are added to the drift report.
+func TestRestoreDriftReport(t *testing.T) {
+	h := newHarness(t)
+
+	h.AddItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "live")).Result(),
+		builder.ForPod("ns-1", "pod-2").Result(),
+	))
+
+	driftReport := new(results.DriftReport)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "backup")).Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+				builder.ForPod("ns-1", "pod-3").Result(),
+			).
+			Done(),
+		DriftReport: driftReport,
+	}
+	h.restorer.Restore(
+		data,
+		nil, // actions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	items := driftReport.Items()
+	require.Len(t, items, 1)
+	assert.Equal(t, "pods", items[0].Resource)
+	assert.Equal(t, "ns-1", items[0].Namespace)
+	assert.Equal(t, "pod-1", items[0].Name)
+	assert.JSONEq(t, `{"metadata":{"labels":{"app":"backup"}}}`, string(items[0].Patch))
+}
+
 func TestRestoreResourcePriorities(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -17,22 +17,34 @@ limitations under the License.
 package logging
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 // LogCounterHook is a logrus hook that counts the number of log
-// statements that have been written at each logrus level.
+// statements that have been written at each logrus level. It also
+// records the warning and error messages, grouped by namespace, so
+// they can be reported as structured results.
 type LogCounterHook struct {
-	mu     sync.RWMutex
-	counts map[logrus.Level]int
+	mu      sync.RWMutex
+	counts  map[logrus.Level]int
+	entries map[logrus.Level]*results.Result
 }
 
 // NewLogCounterHook returns a pointer to an initialized LogCounterHook.
 func NewLogCounterHook() *LogCounterHook {
 	return &LogCounterHook{
 		counts: make(map[logrus.Level]int),
+		entries: map[logrus.Level]*results.Result{
+			logrus.WarnLevel:  {},
+			logrus.ErrorLevel: {},
+		},
 	}
 }
 
@@ -48,9 +60,43 @@ func (h *LogCounterHook) Fire(entry *logrus.Entry) error {
 
 	h.counts[entry.Level]++
 
+	result, ok := h.entries[entry.Level]
+	if !ok {
+		return nil
+	}
+
+	// Items logged with a "namespace" field are recorded against that namespace;
+	// an empty namespace denotes a cluster-scoped item. Anything else relates to
+	// the operation of Velero itself.
+	message := entryMessage(entry)
+	if namespace, ok := entry.Data["namespace"].(string); ok {
+		result.Add(namespace, errors.New(message))
+	} else {
+		result.AddVeleroError(errors.New(message))
+	}
+
 	return nil
 }
 
+// entryMessage returns the message for a log entry, prefixed with the
+// resource and name of the item it relates to and suffixed with the
+// error, if present.
+func entryMessage(entry *logrus.Entry) string {
+	var parts []string
+	if resource, ok := entry.Data["resource"]; ok {
+		parts = append(parts, fmt.Sprintf("resource: %v", resource))
+	}
+	if name, ok := entry.Data["name"]; ok {
+		parts = append(parts, fmt.Sprintf("name: %v", name))
+	}
+	parts = append(parts, fmt.Sprintf("message: %s", entry.Message))
+	if err, ok := entry.Data[logrus.ErrorKey]; ok {
+		parts = append(parts, fmt.Sprintf("error: %v", err))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 // GetCount returns the number of log statements that have been
 // written at the specific level provided.
 func (h *LogCounterHook) GetCount(level logrus.Level) int {
@@ -59,3 +105,20 @@ func (h *LogCounterHook) GetCount(level logrus.Level) int {
 
 	return h.counts[level]
 }
+
+// GetEntries returns the messages that have been written at the
+// specific level provided. Only the warning and error levels are
+// recorded.
+func (h *LogCounterHook) GetEntries(level logrus.Level) results.Result {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result, ok := h.entries[level]
+	if !ok {
+		return results.Result{}
+	}
+
+	var copied results.Result
+	copied.Merge(result)
+	return copied
+}
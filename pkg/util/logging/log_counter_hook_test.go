@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestLogCounterHook(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+
+	hook := NewLogCounterHook()
+	logger.Hooks.Add(hook)
+
+	logger.Info("info message")
+	logger.Warn("velero warning")
+	logger.WithFields(logrus.Fields{
+		"namespace": "ns-1",
+		"resource":  "pods",
+		"name":      "pod-1",
+	}).WithError(errors.New("boom")).Error("Error backing up item")
+	logger.WithFields(logrus.Fields{
+		"namespace": "",
+		"resource":  "persistentvolumes",
+		"name":      "pv-1",
+	}).Error("Error backing up item")
+
+	assert.Equal(t, 1, hook.GetCount(logrus.InfoLevel))
+	assert.Equal(t, 1, hook.GetCount(logrus.WarnLevel))
+	assert.Equal(t, 2, hook.GetCount(logrus.ErrorLevel))
+
+	assert.Equal(t, results.Result{
+		Velero: []string{"message: velero warning"},
+	}, hook.GetEntries(logrus.WarnLevel))
+
+	assert.Equal(t, results.Result{
+		Cluster: []string{"resource: persistentvolumes, name: pv-1, message: Error backing up item"},
+		Namespaces: map[string][]string{
+			"ns-1": {"resource: pods, name: pod-1, message: Error backing up item, error: boom"},
+		},
+	}, hook.GetEntries(logrus.ErrorLevel))
+
+	assert.Equal(t, results.Result{}, hook.GetEntries(logrus.InfoLevel))
+}
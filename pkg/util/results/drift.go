@@ -0,0 +1,79 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// ItemDrift describes how an item that wasn't restored because it already
+// existed in the cluster differs from its backed-up version.
+type ItemDrift struct {
+	// Resource is the group-resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item was restored into. It's empty
+	// for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Patch is a JSON merge patch that would change the in-cluster version
+	// of the item into the backed-up version.
+	Patch json.RawMessage `json:"patch"`
+}
+
+// DriftReport is a collection of the items of a restore that already existed
+// in the cluster with a different version than the backed-up one. It's safe
+// for concurrent use.
+type DriftReport struct {
+	lock  sync.Mutex
+	items []ItemDrift
+}
+
+// Add adds a drifted item to the report.
+func (r *DriftReport) Add(item ItemDrift) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.items = append(r.items, item)
+}
+
+// Items returns the drifted items in the report, sorted by resource,
+// namespace and name.
+func (r *DriftReport) Items() []ItemDrift {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	items := make([]ItemDrift, len(r.items))
+	copy(items, r.items)
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Resource != items[j].Resource {
+			return items[i].Resource < items[j].Resource
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}